@@ -0,0 +1,425 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/0xPellNetwork/pelldvs-libs/log"
+	agggrpc "github.com/0xPellNetwork/pelldvs/aggregator/grpc"
+	aggrpc "github.com/0xPellNetwork/pelldvs/aggregator/rpc"
+	aggtypes "github.com/0xPellNetwork/pelldvs/aggregator/types"
+)
+
+// Strategies used to dispatch operator responses to several aggregators
+const (
+	// StrategyFailover submits to one aggregator at a time and moves on to
+	// the next one when it fails
+	StrategyFailover = "failover"
+	// StrategyBroadcast submits to every aggregator and keeps the first
+	// validated response
+	StrategyBroadcast = "broadcast"
+)
+
+// unhealthyBackoff is how long a failed endpoint is only tried after the
+// healthy ones
+const unhealthyBackoff = 30 * time.Second
+
+// NewAggregatorClient creates the aggregator client of a node from a comma
+// separated list of aggregator URLs. A single URL gives a plain client,
+// several URLs are dispatched to with the given strategy.
+func NewAggregatorClient(urls string, strategy string, logger log.Logger) (aggtypes.Aggregator, error) {
+	var endpoints []Endpoint
+	for _, url := range strings.Split(urls, ",") {
+		url = strings.TrimSpace(url)
+		if url == "" {
+			continue
+		}
+		aggregator, err := newEndpointClient(url, logger)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create client for aggregator %s: %v", url, err)
+		}
+		endpoints = append(endpoints, Endpoint{URL: url, Aggregator: aggregator})
+	}
+
+	if len(endpoints) == 0 {
+		return nil, errors.New("no aggregator URL configured")
+	}
+	if len(endpoints) == 1 {
+		return endpoints[0].Aggregator, nil
+	}
+	return NewMultiAggregator(strategy, logger, endpoints...)
+}
+
+// newEndpointClient connects to the aggregator over gRPC when the URL has the
+// grpc:// scheme and over net/rpc otherwise
+func newEndpointClient(url string, logger log.Logger) (aggtypes.Aggregator, error) {
+	if address, ok := strings.CutPrefix(url, agggrpc.URLScheme); ok {
+		return agggrpc.NewAggregatorGRPCClient(address, logger)
+	}
+	return aggrpc.NewAggregatorRPCClient(url, logger)
+}
+
+// Endpoint is one aggregator a MultiAggregator dispatches responses to
+type Endpoint struct {
+	URL        string
+	Aggregator aggtypes.Aggregator
+}
+
+// EndpointStatus reports the health of an aggregator endpoint as observed
+// from the outcome of the submissions made to it
+type EndpointStatus struct {
+	URL                 string
+	Healthy             bool
+	ConsecutiveFailures int
+	LastError           error
+	LastFailure         time.Time
+}
+
+// endpoint tracks the health of an aggregator. An aggregator that answered
+// with an aggregation error was reachable and is not counted as failing.
+type endpoint struct {
+	url        string
+	aggregator aggtypes.Aggregator
+
+	mtx                 sync.Mutex
+	consecutiveFailures int
+	lastError           error
+	lastFailure         time.Time
+}
+
+func (e *endpoint) recordResult(err error, now time.Time) {
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+
+	var aggErr *aggtypes.AggregationError
+	if err == nil || errors.As(err, &aggErr) {
+		e.consecutiveFailures = 0
+		return
+	}
+	e.consecutiveFailures++
+	e.lastError = err
+	e.lastFailure = now
+}
+
+// backingOff reports whether the endpoint failed recently
+func (e *endpoint) backingOff(now time.Time) bool {
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+	return e.consecutiveFailures > 0 && now.Before(e.lastFailure.Add(unhealthyBackoff))
+}
+
+func (e *endpoint) status() EndpointStatus {
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+	return EndpointStatus{
+		URL:                 e.url,
+		Healthy:             e.consecutiveFailures == 0,
+		ConsecutiveFailures: e.consecutiveFailures,
+		LastError:           e.lastError,
+		LastFailure:         e.lastFailure,
+	}
+}
+
+// MultiAggregator implements the Aggregator interface on top of several
+// aggregators, dispatching each operator response according to its strategy
+type MultiAggregator struct {
+	strategy  string
+	endpoints []*endpoint
+	logger    log.Logger
+	now       func() time.Time
+}
+
+var _ aggtypes.BatchAggregator = (*MultiAggregator)(nil)
+
+// NewMultiAggregator creates an aggregator client dispatching operator
+// responses to the given endpoints with the given strategy. An empty
+// strategy defaults to failover.
+func NewMultiAggregator(strategy string, logger log.Logger, endpoints ...Endpoint) (*MultiAggregator, error) {
+	if strategy == "" {
+		strategy = StrategyFailover
+	}
+	if strategy != StrategyFailover && strategy != StrategyBroadcast {
+		return nil, fmt.Errorf("unknown aggregator strategy %q", strategy)
+	}
+	if len(endpoints) == 0 {
+		return nil, errors.New("no aggregator endpoint")
+	}
+
+	ma := &MultiAggregator{
+		strategy: strategy,
+		logger:   logger,
+		now:      time.Now,
+	}
+	for _, e := range endpoints {
+		ma.endpoints = append(ma.endpoints, &endpoint{url: e.URL, aggregator: e.Aggregator})
+	}
+	return ma, nil
+}
+
+// Endpoints returns the health of every endpoint, in configuration order
+func (ma *MultiAggregator) Endpoints() []EndpointStatus {
+	statuses := make([]EndpointStatus, 0, len(ma.endpoints))
+	for _, e := range ma.endpoints {
+		statuses = append(statuses, e.status())
+	}
+	return statuses
+}
+
+// CollectResponseSignature implements the Aggregator interface
+func (ma *MultiAggregator) CollectResponseSignature(ctx context.Context, response *aggtypes.ResponseWithSignature,
+	validatedResponseCh chan<- aggtypes.ValidatedResponse) error {
+	if ma.strategy == StrategyBroadcast {
+		return ma.broadcast(ctx, response, validatedResponseCh)
+	}
+	return ma.failover(ctx, response, validatedResponseCh)
+}
+
+// failover submits the response to the endpoints one after the other,
+// healthy ones first, until one of them returns a validated response
+func (ma *MultiAggregator) failover(ctx context.Context, response *aggtypes.ResponseWithSignature,
+	validatedResponseCh chan<- aggtypes.ValidatedResponse) error {
+	var errs []error
+	for _, e := range ma.orderedEndpoints() {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		result, err := ma.submit(ctx, e, response)
+		if err != nil {
+			ma.logger.Error("Aggregator failed, trying the next one", "url", e.url, "error", err)
+			errs = append(errs, fmt.Errorf("%s: %w", e.url, err))
+			continue
+		}
+		validatedResponseCh <- result
+		return nil
+	}
+	return fmt.Errorf("all aggregators failed: %w", errors.Join(errs...))
+}
+
+// broadcast submits the response to every endpoint at once and forwards the
+// first validated response. A response that does not include the operator
+// is only forwarded when no aggregator returns one that does.
+func (ma *MultiAggregator) broadcast(ctx context.Context, response *aggtypes.ResponseWithSignature,
+	validatedResponseCh chan<- aggtypes.ValidatedResponse) error {
+	type outcome struct {
+		url    string
+		result aggtypes.ValidatedResponse
+		err    error
+	}
+
+	// buffered so that the submissions still running after a result was
+	// forwarded can complete and record the health of their endpoint
+	outcomes := make(chan outcome, len(ma.endpoints))
+	for _, e := range ma.endpoints {
+		go func(e *endpoint) {
+			result, err := ma.submit(ctx, e, response)
+			outcomes <- outcome{url: e.url, result: result, err: err}
+		}(e)
+	}
+
+	var (
+		errs     []error
+		fallback *aggtypes.ValidatedResponse
+	)
+	for range ma.endpoints {
+		var o outcome
+		select {
+		case o = <-outcomes:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		if o.err != nil {
+			ma.logger.Error("Aggregator failed", "url", o.url, "error", o.err)
+			errs = append(errs, fmt.Errorf("%s: %w", o.url, o.err))
+			continue
+		}
+		if o.result.NotIncluded {
+			if fallback == nil {
+				fallback = &o.result
+			}
+			continue
+		}
+		validatedResponseCh <- o.result
+		return nil
+	}
+
+	if fallback != nil {
+		validatedResponseCh <- *fallback
+		return nil
+	}
+	return fmt.Errorf("all aggregators failed: %w", errors.Join(errs...))
+}
+
+// submit sends the response to a single endpoint and records the outcome
+func (ma *MultiAggregator) submit(ctx context.Context, e *endpoint,
+	response *aggtypes.ResponseWithSignature) (aggtypes.ValidatedResponse, error) {
+	resultCh := make(chan aggtypes.ValidatedResponse, 1)
+	err := e.aggregator.CollectResponseSignature(ctx, response, resultCh)
+	ma.recordResult(ctx, e, err)
+	if err != nil {
+		return aggtypes.ValidatedResponse{}, err
+	}
+	return <-resultCh, nil
+}
+
+// CollectResponseSignatures implements the BatchAggregator interface. The
+// batch is dispatched to the endpoints as a whole, with the same strategy as
+// single responses.
+func (ma *MultiAggregator) CollectResponseSignatures(ctx context.Context, responses []*aggtypes.ResponseWithSignature,
+	resultsCh chan<- []aggtypes.ValidatedResponse) error {
+	if ma.strategy == StrategyBroadcast {
+		return ma.broadcastBatch(ctx, responses, resultsCh)
+	}
+	return ma.failoverBatch(ctx, responses, resultsCh)
+}
+
+// failoverBatch submits the batch to the endpoints one after the other,
+// healthy ones first, until one of them returns the validated responses
+func (ma *MultiAggregator) failoverBatch(ctx context.Context, responses []*aggtypes.ResponseWithSignature,
+	resultsCh chan<- []aggtypes.ValidatedResponse) error {
+	var errs []error
+	for _, e := range ma.orderedEndpoints() {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		results, err := ma.submitBatch(ctx, e, responses)
+		if err != nil {
+			ma.logger.Error("Aggregator failed, trying the next one", "url", e.url, "error", err)
+			errs = append(errs, fmt.Errorf("%s: %w", e.url, err))
+			continue
+		}
+		resultsCh <- results
+		return nil
+	}
+	return fmt.Errorf("all aggregators failed: %w", errors.Join(errs...))
+}
+
+// broadcastBatch submits the batch to every endpoint at once and forwards the
+// first validated responses that all include the operator, or else the first
+// ones returned
+func (ma *MultiAggregator) broadcastBatch(ctx context.Context, responses []*aggtypes.ResponseWithSignature,
+	resultsCh chan<- []aggtypes.ValidatedResponse) error {
+	type outcome struct {
+		url     string
+		results []aggtypes.ValidatedResponse
+		err     error
+	}
+
+	outcomes := make(chan outcome, len(ma.endpoints))
+	for _, e := range ma.endpoints {
+		go func(e *endpoint) {
+			results, err := ma.submitBatch(ctx, e, responses)
+			outcomes <- outcome{url: e.url, results: results, err: err}
+		}(e)
+	}
+
+	var (
+		errs     []error
+		fallback []aggtypes.ValidatedResponse
+	)
+	for range ma.endpoints {
+		var o outcome
+		select {
+		case o = <-outcomes:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		if o.err != nil {
+			ma.logger.Error("Aggregator failed", "url", o.url, "error", o.err)
+			errs = append(errs, fmt.Errorf("%s: %w", o.url, o.err))
+			continue
+		}
+		if !allIncluded(o.results) {
+			if fallback == nil {
+				fallback = o.results
+			}
+			continue
+		}
+		resultsCh <- o.results
+		return nil
+	}
+
+	if fallback != nil {
+		resultsCh <- fallback
+		return nil
+	}
+	return fmt.Errorf("all aggregators failed: %w", errors.Join(errs...))
+}
+
+// submitBatch sends the responses to a single endpoint and records the
+// outcome. Endpoints that do not accept batches get the responses one by
+// one, concurrently, and answer each with its result or aggregation error.
+func (ma *MultiAggregator) submitBatch(ctx context.Context, e *endpoint,
+	responses []*aggtypes.ResponseWithSignature) ([]aggtypes.ValidatedResponse, error) {
+	if batcher, ok := e.aggregator.(aggtypes.BatchAggregator); ok {
+		resultsCh := make(chan []aggtypes.ValidatedResponse, 1)
+		err := batcher.CollectResponseSignatures(ctx, responses, resultsCh)
+		ma.recordResult(ctx, e, err)
+		if err != nil {
+			return nil, err
+		}
+		return <-resultsCh, nil
+	}
+
+	results := make([]aggtypes.ValidatedResponse, len(responses))
+	errs := make([]error, len(responses))
+	var wg sync.WaitGroup
+	for i, response := range responses {
+		wg.Add(1)
+		go func(i int, response *aggtypes.ResponseWithSignature) {
+			defer wg.Done()
+			results[i], errs[i] = ma.submit(ctx, e, response)
+		}(i, response)
+	}
+	wg.Wait()
+
+	for i, err := range errs {
+		if err == nil {
+			continue
+		}
+		var aggErr *aggtypes.AggregationError
+		if !errors.As(err, &aggErr) {
+			return nil, err
+		}
+		results[i] = aggtypes.ValidatedResponse{Err: aggErr.Err}
+	}
+	return results, nil
+}
+
+// recordResult records the outcome of a call to the endpoint. Calls
+// abandoned because the context is done say nothing of the endpoint health.
+func (ma *MultiAggregator) recordResult(ctx context.Context, e *endpoint, err error) {
+	if err != nil && ctx.Err() != nil {
+		return
+	}
+	e.recordResult(err, ma.now())
+}
+
+// allIncluded reports whether every result includes the operator
+func allIncluded(results []aggtypes.ValidatedResponse) bool {
+	for _, result := range results {
+		if result.NotIncluded {
+			return false
+		}
+	}
+	return true
+}
+
+// orderedEndpoints returns the endpoints in configuration order, with the
+// ones that failed recently moved to the end
+func (ma *MultiAggregator) orderedEndpoints() []*endpoint {
+	now := ma.now()
+	ordered := make([]*endpoint, 0, len(ma.endpoints))
+	var backingOff []*endpoint
+	for _, e := range ma.endpoints {
+		if e.backingOff(now) {
+			backingOff = append(backingOff, e)
+			continue
+		}
+		ordered = append(ordered, e)
+	}
+	return append(ordered, backingOff...)
+}
@@ -0,0 +1,191 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPellNetwork/pelldvs-libs/log"
+	aggtypes "github.com/0xPellNetwork/pelldvs/aggregator/types"
+	rpctypes "github.com/0xPellNetwork/pelldvs/rpc/jsonrpc/types"
+)
+
+// fakeAggregator answers with a fixed result or error after an optional delay
+type fakeAggregator struct {
+	result aggtypes.ValidatedResponse
+	err    error
+	delay  time.Duration
+
+	mtx   sync.Mutex
+	calls int
+}
+
+func (f *fakeAggregator) CollectResponseSignature(_ context.Context, _ *aggtypes.ResponseWithSignature,
+	ch chan<- aggtypes.ValidatedResponse) error {
+	f.mtx.Lock()
+	f.calls++
+	f.mtx.Unlock()
+	time.Sleep(f.delay)
+	if f.err != nil {
+		return f.err
+	}
+	ch <- f.result
+	return nil
+}
+
+// fakeBatchAggregator also accepts batches, answering each response with the
+// fixed result
+type fakeBatchAggregator struct {
+	fakeAggregator
+	batches int
+}
+
+func (f *fakeBatchAggregator) CollectResponseSignatures(_ context.Context, responses []*aggtypes.ResponseWithSignature,
+	ch chan<- []aggtypes.ValidatedResponse) error {
+	f.batches++
+	if f.err != nil {
+		return f.err
+	}
+	results := make([]aggtypes.ValidatedResponse, len(responses))
+	for i := range results {
+		results[i] = f.result
+	}
+	ch <- results
+	return nil
+}
+
+func newTestMultiAggregator(t *testing.T, strategy string, aggregators ...*fakeAggregator) *MultiAggregator {
+	endpoints := make([]Endpoint, 0, len(aggregators))
+	for i, a := range aggregators {
+		endpoints = append(endpoints, Endpoint{URL: string(rune('a' + i)), Aggregator: a})
+	}
+	ma, err := NewMultiAggregator(strategy, log.NewNopLogger(), endpoints...)
+	require.NoError(t, err)
+	return ma
+}
+
+func collect(t *testing.T, ma *MultiAggregator) (aggtypes.ValidatedResponse, error) {
+	ch := make(chan aggtypes.ValidatedResponse, 1)
+	if err := ma.CollectResponseSignature(context.Background(), &aggtypes.ResponseWithSignature{}, ch); err != nil {
+		return aggtypes.ValidatedResponse{}, err
+	}
+	select {
+	case result := <-ch:
+		return result, nil
+	default:
+		t.Fatal("no result sent on the channel")
+		return aggtypes.ValidatedResponse{}, nil
+	}
+}
+
+func TestMultiAggregatorFailover(t *testing.T) {
+	down := &fakeAggregator{err: errors.New("connection refused")}
+	up := &fakeAggregator{result: aggtypes.ValidatedResponse{Data: []byte("up")}}
+	ma := newTestMultiAggregator(t, StrategyFailover, down, up)
+
+	result, err := collect(t, ma)
+	require.NoError(t, err)
+	require.Equal(t, []byte("up"), result.Data)
+
+	statuses := ma.Endpoints()
+	require.False(t, statuses[0].Healthy)
+	require.Equal(t, 1, statuses[0].ConsecutiveFailures)
+	require.True(t, statuses[1].Healthy)
+
+	// the failed endpoint is tried last while it backs off
+	_, err = collect(t, ma)
+	require.NoError(t, err)
+	require.Equal(t, 1, down.calls)
+	require.Equal(t, 2, up.calls)
+
+	// and first again once the backoff is over
+	ma.now = func() time.Time { return time.Now().Add(unhealthyBackoff) }
+	_, err = collect(t, ma)
+	require.NoError(t, err)
+	require.Equal(t, 2, down.calls)
+}
+
+func TestMultiAggregatorFailoverAllFail(t *testing.T) {
+	aggErr := &aggtypes.AggregationError{Err: &rpctypes.RPCError{Code: 32000, Message: "threshold not met"}}
+	ma := newTestMultiAggregator(t, StrategyFailover,
+		&fakeAggregator{err: aggErr},
+		&fakeAggregator{err: errors.New("connection refused")},
+	)
+
+	_, err := collect(t, ma)
+	require.Error(t, err)
+	require.ErrorIs(t, err, aggErr)
+
+	// an aggregator that answered with an error is still healthy
+	statuses := ma.Endpoints()
+	require.True(t, statuses[0].Healthy)
+	require.False(t, statuses[1].Healthy)
+}
+
+func TestMultiAggregatorBroadcast(t *testing.T) {
+	slow := &fakeAggregator{result: aggtypes.ValidatedResponse{Data: []byte("slow")}, delay: time.Second}
+	fast := &fakeAggregator{result: aggtypes.ValidatedResponse{Data: []byte("fast")}}
+	down := &fakeAggregator{err: errors.New("connection refused")}
+	ma := newTestMultiAggregator(t, StrategyBroadcast, slow, fast, down)
+
+	result, err := collect(t, ma)
+	require.NoError(t, err)
+	require.Equal(t, []byte("fast"), result.Data)
+}
+
+func TestMultiAggregatorBroadcastPrefersIncluded(t *testing.T) {
+	late := &fakeAggregator{result: aggtypes.ValidatedResponse{Data: []byte("late"), NotIncluded: true}}
+	included := &fakeAggregator{
+		result: aggtypes.ValidatedResponse{Data: []byte("included")},
+		delay:  50 * time.Millisecond,
+	}
+	ma := newTestMultiAggregator(t, StrategyBroadcast, late, included)
+
+	result, err := collect(t, ma)
+	require.NoError(t, err)
+	require.Equal(t, []byte("included"), result.Data)
+
+	ma = newTestMultiAggregator(t, StrategyBroadcast, late, &fakeAggregator{err: errors.New("connection refused")})
+	result, err = collect(t, ma)
+	require.NoError(t, err)
+	require.True(t, result.NotIncluded)
+}
+
+func TestMultiAggregatorBatch(t *testing.T) {
+	down := &fakeBatchAggregator{fakeAggregator: fakeAggregator{err: errors.New("connection refused")}}
+	up := &fakeBatchAggregator{fakeAggregator: fakeAggregator{result: aggtypes.ValidatedResponse{Data: []byte("up")}}}
+	ma, err := NewMultiAggregator(StrategyFailover, log.NewNopLogger(),
+		Endpoint{URL: "a", Aggregator: down}, Endpoint{URL: "b", Aggregator: up})
+	require.NoError(t, err)
+
+	responses := []*aggtypes.ResponseWithSignature{{}, {}}
+	ch := make(chan []aggtypes.ValidatedResponse, 1)
+	require.NoError(t, ma.CollectResponseSignatures(context.Background(), responses, ch))
+	results := <-ch
+	require.Len(t, results, 2)
+	require.Equal(t, []byte("up"), results[1].Data)
+	require.Equal(t, 1, down.batches)
+	require.Equal(t, 1, up.batches)
+	require.False(t, ma.Endpoints()[0].Healthy)
+
+	// endpoints without batch support get the responses one by one, and
+	// aggregation errors are returned as the result of their response
+	aggErr := &aggtypes.AggregationError{Err: &rpctypes.RPCError{Code: 32000, Message: "threshold not met"}}
+	single := &fakeAggregator{err: aggErr}
+	ma = newTestMultiAggregator(t, StrategyBroadcast, single)
+	require.NoError(t, ma.CollectResponseSignatures(context.Background(), responses, ch))
+	results = <-ch
+	require.Len(t, results, 2)
+	require.Equal(t, aggErr.Err, results[0].Err)
+	require.Equal(t, 2, single.calls)
+	require.True(t, ma.Endpoints()[0].Healthy)
+}
+
+func TestNewMultiAggregatorUnknownStrategy(t *testing.T) {
+	_, err := NewMultiAggregator("random", log.NewNopLogger(), Endpoint{URL: "a", Aggregator: &fakeAggregator{}})
+	require.Error(t, err)
+}
@@ -14,6 +14,18 @@ import (
 const (
 	DefaultAggregatorRPCServer     = "0.0.0.0:26653"
 	DefaultOperatorResponseTimeout = 5 * time.Second
+	DefaultResultRetention         = time.Hour
+	DefaultResultCacheTTL          = 10 * time.Minute
+	DefaultPrometheusListenAddr    = ":26661"
+	DefaultShutdownDrainTimeout    = 10 * time.Second
+
+	DefaultMaxConcurrentTasks         = 1000
+	DefaultMaxConcurrentTasksPerChain = 200
+	DefaultMaxRequestDataSize         = 1 << 20 // 1 MiB
+	DefaultMaxGroupNumbers            = 32
+	DefaultMaxBatchSize               = 64
+
+	DefaultOperatorSnapshotCacheSize = 256
 )
 
 // ChainID represents a unique identifier for a blockchain network
@@ -22,8 +34,34 @@ type ChainID = int64
 // AggregatorConfig stores configuration settings for the aggregator service
 // including network addresses and timeout values
 type AggregatorConfig struct {
-	AggregatorRPCServer     string `json:"aggregator_rpc_server"`
+	AggregatorRPCServer string `json:"aggregator_rpc_server"`
+	// Address the gRPC server listens on, e.g. 127.0.0.1:26654. The gRPC
+	// server is not started when empty.
+	AggregatorGRPCServer    string `json:"aggregator_grpc_server"`
 	OperatorResponseTimeout string `json:"operator_response_timeout"`
+	ResultRetention         string `json:"result_retention"`
+	ResultCacheTTL          string `json:"result_cache_ttl"`
+	// How long the aggregator waits on stop for its in-flight tasks to be
+	// finalized or failed and for their operators to get an answer.
+	ShutdownDrainTimeout string `json:"shutdown_drain_timeout"`
+	// When true, operator submissions are accepted without the ECDSA
+	// signature of their operator. Meant for local testing only.
+	AllowUnauthenticated bool `json:"allow_unauthenticated"`
+	// Limits on the requests the aggregator creates tasks for. Submissions
+	// exceeding them are rejected before any chain read.
+	MaxConcurrentTasks         int `json:"max_concurrent_tasks"`
+	MaxConcurrentTasksPerChain int `json:"max_concurrent_tasks_per_chain"`
+	MaxRequestDataSize         int `json:"max_request_data_size"`
+	MaxGroupNumbers            int `json:"max_group_numbers"`
+	// Maximum number of responses an operator submits in a single batch.
+	MaxBatchSize int `json:"max_batch_size"`
+	// Number of operator set snapshots, one per chain, block and groups,
+	// kept in memory and shared by the tasks reading the same state.
+	OperatorSnapshotCacheSize int `json:"operator_snapshot_cache_size"`
+	// When true, Prometheus metrics are served under /metrics on
+	// PrometheusListenAddr.
+	Prometheus           bool   `json:"prometheus"`
+	PrometheusListenAddr string `json:"prometheus_listen_addr"`
 }
 
 // ChainConfig stores chain-specific configuration parameters
@@ -68,6 +106,56 @@ func (c *AggregatorConfig) Finalize(logger log.Logger) {
 			"value", DefaultOperatorResponseTimeout)
 		c.OperatorResponseTimeout = DefaultOperatorResponseTimeout.String()
 	}
+	if c.ResultRetention == "" {
+		logger.Warn("AggregatorConfig: Result retention is not set, using default",
+			"value", DefaultResultRetention)
+		c.ResultRetention = DefaultResultRetention.String()
+	}
+	if c.ResultCacheTTL == "" {
+		logger.Warn("AggregatorConfig: Result cache TTL is not set, using default",
+			"value", DefaultResultCacheTTL)
+		c.ResultCacheTTL = DefaultResultCacheTTL.String()
+	}
+	if c.ShutdownDrainTimeout == "" {
+		logger.Warn("AggregatorConfig: Shutdown drain timeout is not set, using default",
+			"value", DefaultShutdownDrainTimeout)
+		c.ShutdownDrainTimeout = DefaultShutdownDrainTimeout.String()
+	}
+	if c.MaxConcurrentTasks <= 0 {
+		logger.Warn("AggregatorConfig: Max concurrent tasks is not set, using default",
+			"value", DefaultMaxConcurrentTasks)
+		c.MaxConcurrentTasks = DefaultMaxConcurrentTasks
+	}
+	if c.MaxConcurrentTasksPerChain <= 0 {
+		logger.Warn("AggregatorConfig: Max concurrent tasks per chain is not set, using default",
+			"value", DefaultMaxConcurrentTasksPerChain)
+		c.MaxConcurrentTasksPerChain = DefaultMaxConcurrentTasksPerChain
+	}
+	if c.MaxRequestDataSize <= 0 {
+		logger.Warn("AggregatorConfig: Max request data size is not set, using default",
+			"value", DefaultMaxRequestDataSize)
+		c.MaxRequestDataSize = DefaultMaxRequestDataSize
+	}
+	if c.MaxGroupNumbers <= 0 {
+		logger.Warn("AggregatorConfig: Max group numbers is not set, using default",
+			"value", DefaultMaxGroupNumbers)
+		c.MaxGroupNumbers = DefaultMaxGroupNumbers
+	}
+	if c.MaxBatchSize <= 0 {
+		logger.Warn("AggregatorConfig: Max batch size is not set, using default",
+			"value", DefaultMaxBatchSize)
+		c.MaxBatchSize = DefaultMaxBatchSize
+	}
+	if c.OperatorSnapshotCacheSize <= 0 {
+		logger.Warn("AggregatorConfig: Operator snapshot cache size is not set, using default",
+			"value", DefaultOperatorSnapshotCacheSize)
+		c.OperatorSnapshotCacheSize = DefaultOperatorSnapshotCacheSize
+	}
+	if c.Prometheus && c.PrometheusListenAddr == "" {
+		logger.Warn("AggregatorConfig: Prometheus listen address is not set, using default",
+			"value", DefaultPrometheusListenAddr)
+		c.PrometheusListenAddr = DefaultPrometheusListenAddr
+	}
 }
 
 // GetOperatorResponseTimeout converts the string timeout value to a time.Duration.
@@ -85,3 +173,48 @@ func (c *AggregatorConfig) GetOperatorResponseTimeout(logger log.Logger) (time.D
 	}
 	return timeout, nil
 }
+
+// GetResultRetention converts the string retention value to a time.Duration.
+// Finalized results are kept in the task store for this long before they are pruned.
+func (c *AggregatorConfig) GetResultRetention(logger log.Logger) (time.Duration, error) {
+	retention, err := time.ParseDuration(c.ResultRetention)
+	if err != nil {
+		logger.Error("Invalid result retention", "error", err, "value", c.ResultRetention)
+		return DefaultResultRetention, fmt.Errorf("invalid result retention: %v", err)
+	}
+	if retention <= 0 {
+		logger.Warn("Result retention is not positive, using default", "value", retention)
+		retention = DefaultResultRetention
+	}
+	return retention, nil
+}
+
+// GetResultCacheTTL converts the string TTL value to a time.Duration.
+// Operators submitting after a task is finalized get its cached result for this long.
+func (c *AggregatorConfig) GetResultCacheTTL(logger log.Logger) (time.Duration, error) {
+	ttl, err := time.ParseDuration(c.ResultCacheTTL)
+	if err != nil {
+		logger.Error("Invalid result cache TTL", "error", err, "value", c.ResultCacheTTL)
+		return DefaultResultCacheTTL, fmt.Errorf("invalid result cache TTL: %v", err)
+	}
+	if ttl <= 0 {
+		logger.Warn("Result cache TTL is not positive, using default", "value", ttl)
+		ttl = DefaultResultCacheTTL
+	}
+	return ttl, nil
+}
+
+// GetShutdownDrainTimeout converts the string drain timeout value to a time.Duration.
+// In-flight tasks not drained within it are left to be resumed on the next start.
+func (c *AggregatorConfig) GetShutdownDrainTimeout(logger log.Logger) (time.Duration, error) {
+	timeout, err := time.ParseDuration(c.ShutdownDrainTimeout)
+	if err != nil {
+		logger.Error("Invalid shutdown drain timeout", "error", err, "value", c.ShutdownDrainTimeout)
+		return DefaultShutdownDrainTimeout, fmt.Errorf("invalid shutdown drain timeout: %v", err)
+	}
+	if timeout <= 0 {
+		logger.Warn("Shutdown drain timeout is not positive, using default", "value", timeout)
+		timeout = DefaultShutdownDrainTimeout
+	}
+	return timeout, nil
+}
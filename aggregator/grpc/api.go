@@ -0,0 +1,130 @@
+package agggrpc
+
+import (
+	"context"
+	"errors"
+
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+
+	aggtypes "github.com/0xPellNetwork/pelldvs/aggregator/types"
+)
+
+// Service is the aggregator exposed by the gRPC server. It mirrors the
+// methods the aggregator serves over net/rpc.
+type Service interface {
+	CollectResponseSignature(response *aggtypes.ResponseWithSignature, result *aggtypes.ValidatedResponse) error
+	CollectResponseSignatures(responses []*aggtypes.ResponseWithSignature, results *[]aggtypes.ValidatedResponse) error
+	GetTaskStatus(requestHash []byte, reply *aggtypes.TaskStatus) error
+	ListTasks(_ struct{}, reply *[]aggtypes.TaskInfo) error
+	GetTaskResult(requestHash []byte, reply *aggtypes.ValidatedResponse) error
+	ListEvidence(query aggtypes.EvidenceQuery, reply *[]aggtypes.Evidence) error
+	IsRunning() bool
+}
+
+// AggregatorAPIServerAPI implements AggregatorAPIServer on top of an aggregator Service
+type AggregatorAPIServerAPI struct {
+	aggregator Service
+}
+
+func (api *AggregatorAPIServerAPI) CollectResponseSignature(_ context.Context,
+	req *ResponseWithSignature) (*ValidatedResponse, error) {
+	response, err := ResponseWithSignatureFromProto(req)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid response: %v", err)
+	}
+
+	var result aggtypes.ValidatedResponse
+	if err := api.aggregator.CollectResponseSignature(response, &result); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to collect response signature: %v", err)
+	}
+
+	return ValidatedResponseToProto(&result), nil
+}
+
+func (api *AggregatorAPIServerAPI) CollectResponseSignatures(_ context.Context,
+	req *RequestCollectResponseSignatures) (*ResponseCollectResponseSignatures, error) {
+	responses := make([]*aggtypes.ResponseWithSignature, 0, len(req.Responses))
+	for i, pb := range req.Responses {
+		response, err := ResponseWithSignatureFromProto(pb)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid response %d: %v", i, err)
+		}
+		responses = append(responses, response)
+	}
+
+	var results []aggtypes.ValidatedResponse
+	if err := api.aggregator.CollectResponseSignatures(responses, &results); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to collect response signatures: %v", err)
+	}
+
+	res := &ResponseCollectResponseSignatures{Results: make([]*ValidatedResponse, 0, len(results))}
+	for i := range results {
+		res.Results = append(res.Results, ValidatedResponseToProto(&results[i]))
+	}
+	return res, nil
+}
+
+func (api *AggregatorAPIServerAPI) HealthCheck(_ context.Context, _ *RequestHealthCheck) (*ResponseHealthCheck, error) {
+	return &ResponseHealthCheck{Healthy: api.aggregator.IsRunning()}, nil
+}
+
+func (api *AggregatorAPIServerAPI) TaskStatus(_ context.Context, req *RequestTaskStatus) (*ResponseTaskStatus, error) {
+	if len(req.RequestHash) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "request hash is missing")
+	}
+
+	var taskStatus aggtypes.TaskStatus
+	if err := api.aggregator.GetTaskStatus(req.RequestHash, &taskStatus); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get task status: %v", err)
+	}
+
+	return TaskStatusToProto(&taskStatus), nil
+}
+
+func (api *AggregatorAPIServerAPI) ListTasks(_ context.Context, _ *RequestListTasks) (*ResponseListTasks, error) {
+	var tasks []aggtypes.TaskInfo
+	if err := api.aggregator.ListTasks(struct{}{}, &tasks); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list tasks: %v", err)
+	}
+
+	res := &ResponseListTasks{Tasks: make([]*TaskInfo, 0, len(tasks))}
+	for i := range tasks {
+		res.Tasks = append(res.Tasks, TaskInfoToProto(&tasks[i]))
+	}
+	return res, nil
+}
+
+func (api *AggregatorAPIServerAPI) TaskResult(_ context.Context, req *RequestTaskResult) (*ValidatedResponse, error) {
+	if len(req.RequestHash) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "request hash is missing")
+	}
+
+	var result aggtypes.ValidatedResponse
+	if err := api.aggregator.GetTaskResult(req.RequestHash, &result); err != nil {
+		if errors.Is(err, aggtypes.ErrTaskResultNotFound) {
+			return nil, status.Errorf(codes.NotFound, "no result for request %X", req.RequestHash)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get task result: %v", err)
+	}
+
+	return ValidatedResponseToProto(&result), nil
+}
+
+func (api *AggregatorAPIServerAPI) ListEvidence(_ context.Context, req *RequestListEvidence) (*ResponseListEvidence, error) {
+	if len(req.OperatorId) != 0 && len(req.OperatorId) != 32 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid operator ID size %d, expected 32", len(req.OperatorId))
+	}
+
+	var evidence []aggtypes.Evidence
+	query := aggtypes.EvidenceQuery{OperatorID: req.OperatorId, RequestHash: req.RequestHash}
+	if err := api.aggregator.ListEvidence(query, &evidence); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list evidence: %v", err)
+	}
+
+	res := &ResponseListEvidence{Evidence: make([]*Evidence, 0, len(evidence))}
+	for i := range evidence {
+		res.Evidence = append(res.Evidence, EvidenceToProto(&evidence[i]))
+	}
+	return res, nil
+}
@@ -0,0 +1,179 @@
+package agggrpc
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	"github.com/0xPellNetwork/pelldvs-libs/log"
+	aggtypes "github.com/0xPellNetwork/pelldvs/aggregator/types"
+)
+
+// AggregatorGRPCClient provides a client implementation of the Aggregator interface
+// communicating with the aggregator service over gRPC
+type AggregatorGRPCClient struct {
+	conn   *grpc.ClientConn
+	client AggregatorAPIClient
+	logger log.Logger
+}
+
+var _ aggtypes.BatchAggregator = (*AggregatorGRPCClient)(nil)
+
+// NewAggregatorGRPCClient creates a new client instance for the aggregator gRPC
+// server at the specified address. The connection is established lazily on the first call.
+func NewAggregatorGRPCClient(address string, logger log.Logger) (*AggregatorGRPCClient, error) {
+	conn, err := grpc.NewClient(address,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithContextDialer(dialerFunc),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to aggregator: %v", err)
+	}
+
+	return &AggregatorGRPCClient{
+		conn:   conn,
+		client: NewAggregatorAPIClient(conn),
+		logger: logger,
+	}, nil
+}
+
+// CollectResponseSignature implements the Aggregator interface by forwarding the response
+// to the gRPC server and receiving the validated response
+func (ra *AggregatorGRPCClient) CollectResponseSignature(ctx context.Context,
+	responseWithSignature *aggtypes.ResponseWithSignature,
+	validatedResponseCh chan<- aggtypes.ValidatedResponse) error {
+	ra.logger.Info("AggregatorClient: Calling gRPC method to collect responseWithSignature signature",
+		"ResponseWithSignature", responseWithSignature,
+	)
+	pb, err := ra.client.CollectResponseSignature(ctx, ResponseWithSignatureToProto(responseWithSignature))
+	if err != nil {
+		return fmt.Errorf("failed to call aggregator gRPC method: %v", err)
+	}
+
+	result, err := ValidatedResponseFromProto(pb)
+	if err != nil {
+		return fmt.Errorf("failed to decode aggregator response: %v", err)
+	}
+
+	if result.Err != nil {
+		return &aggtypes.AggregationError{Err: result.Err}
+	}
+
+	ra.logger.Info("AggregatorClient: Received validated responseWithSignature", "result", result)
+	validatedResponseCh <- *result
+	ra.logger.Info("AggregatorClient: Sent validated responseWithSignature to channel")
+	return nil
+}
+
+// CollectResponseSignatures implements the BatchAggregator interface by forwarding
+// the responses to the gRPC server in a single call
+func (ra *AggregatorGRPCClient) CollectResponseSignatures(ctx context.Context,
+	responses []*aggtypes.ResponseWithSignature,
+	resultsCh chan<- []aggtypes.ValidatedResponse) error {
+	ra.logger.Info("AggregatorClient: Calling gRPC method to collect batch of response signatures",
+		"responses", len(responses),
+	)
+	req := &RequestCollectResponseSignatures{Responses: make([]*ResponseWithSignature, 0, len(responses))}
+	for _, response := range responses {
+		req.Responses = append(req.Responses, ResponseWithSignatureToProto(response))
+	}
+
+	res, err := ra.client.CollectResponseSignatures(ctx, req)
+	if err != nil {
+		return fmt.Errorf("failed to call aggregator gRPC method: %v", err)
+	}
+	if len(res.Results) != len(responses) {
+		return fmt.Errorf("aggregator returned %d results for %d responses", len(res.Results), len(responses))
+	}
+
+	results := make([]aggtypes.ValidatedResponse, 0, len(res.Results))
+	for _, pb := range res.Results {
+		result, err := ValidatedResponseFromProto(pb)
+		if err != nil {
+			return fmt.Errorf("failed to decode aggregator response: %v", err)
+		}
+		results = append(results, *result)
+	}
+
+	resultsCh <- results
+	return nil
+}
+
+// HealthCheck performs a health check on the aggregator service
+func (ra *AggregatorGRPCClient) HealthCheck() (bool, error) {
+	res, err := ra.client.HealthCheck(context.Background(), &RequestHealthCheck{})
+	if err != nil {
+		return false, fmt.Errorf("failed to call aggregator gRPC method: %v", err)
+	}
+	return res.Healthy, nil
+}
+
+// TaskStatus queries the state of the aggregation task of the request with the given hash
+func (ra *AggregatorGRPCClient) TaskStatus(requestHash []byte) (*aggtypes.TaskStatus, error) {
+	res, err := ra.client.TaskStatus(context.Background(), &RequestTaskStatus{RequestHash: requestHash})
+	if err != nil {
+		return nil, fmt.Errorf("failed to call aggregator gRPC method: %v", err)
+	}
+	return TaskStatusFromProto(res)
+}
+
+// ListTasks returns the in-flight aggregation tasks of the aggregator
+func (ra *AggregatorGRPCClient) ListTasks() ([]aggtypes.TaskInfo, error) {
+	res, err := ra.client.ListTasks(context.Background(), &RequestListTasks{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to call aggregator gRPC method: %v", err)
+	}
+
+	tasks := make([]aggtypes.TaskInfo, 0, len(res.Tasks))
+	for _, pb := range res.Tasks {
+		task, err := TaskInfoFromProto(pb)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode task: %v", err)
+		}
+		tasks = append(tasks, *task)
+	}
+	return tasks, nil
+}
+
+// TaskResult returns the result of the finalized task of the request with the given hash
+func (ra *AggregatorGRPCClient) TaskResult(requestHash []byte) (*aggtypes.ValidatedResponse, error) {
+	res, err := ra.client.TaskResult(context.Background(), &RequestTaskResult{RequestHash: requestHash})
+	if status.Code(err) == codes.NotFound {
+		return nil, aggtypes.ErrTaskResultNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to call aggregator gRPC method: %v", err)
+	}
+	return ValidatedResponseFromProto(res)
+}
+
+// ListEvidence returns the evidence of operators that signed conflicting
+// digests for a request, filtered by the query
+func (ra *AggregatorGRPCClient) ListEvidence(query aggtypes.EvidenceQuery) ([]aggtypes.Evidence, error) {
+	res, err := ra.client.ListEvidence(context.Background(), &RequestListEvidence{
+		OperatorId:  query.OperatorID,
+		RequestHash: query.RequestHash,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to call aggregator gRPC method: %v", err)
+	}
+
+	evidence := make([]aggtypes.Evidence, 0, len(res.Evidence))
+	for _, pb := range res.Evidence {
+		ev, err := EvidenceFromProto(pb)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode evidence: %v", err)
+		}
+		evidence = append(evidence, *ev)
+	}
+	return evidence, nil
+}
+
+// Close closes the connection to the aggregator
+func (ra *AggregatorGRPCClient) Close() error {
+	return ra.conn.Close()
+}
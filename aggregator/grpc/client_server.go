@@ -0,0 +1,25 @@
+package agggrpc
+
+import (
+	"context"
+	"net"
+
+	"google.golang.org/grpc"
+
+	cmtnet "github.com/0xPellNetwork/pelldvs/libs/net"
+)
+
+// URLScheme selects the gRPC transport in an aggregator URL, e.g.
+// grpc://127.0.0.1:26654. URLs without it use the net/rpc transport.
+const URLScheme = "grpc://"
+
+// NewGRPCServer returns a gRPC server serving the aggregator API of the given aggregator
+func NewGRPCServer(aggregator Service) *grpc.Server {
+	grpcServer := grpc.NewServer()
+	RegisterAggregatorAPIServer(grpcServer, &AggregatorAPIServerAPI{aggregator: aggregator})
+	return grpcServer
+}
+
+func dialerFunc(_ context.Context, addr string) (net.Conn, error) {
+	return cmtnet.Connect(addr)
+}
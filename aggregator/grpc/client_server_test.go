@@ -0,0 +1,270 @@
+package agggrpc
+
+import (
+	"context"
+	"math/big"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPellNetwork/pelldvs-libs/crypto/bls"
+	"github.com/0xPellNetwork/pelldvs-libs/log"
+	aggtypes "github.com/0xPellNetwork/pelldvs/aggregator/types"
+	avsitypes "github.com/0xPellNetwork/pelldvs/avsi/types"
+	rpctypes "github.com/0xPellNetwork/pelldvs/rpc/jsonrpc/types"
+)
+
+// echoAggregator answers every response with a result built from it
+type echoAggregator struct {
+	received      *aggtypes.ResponseWithSignature
+	keyPair       *bls.KeyPair
+	err           *rpctypes.RPCError
+	evidence      []aggtypes.Evidence
+	evidenceQuery aggtypes.EvidenceQuery
+}
+
+func (a *echoAggregator) CollectResponseSignature(response *aggtypes.ResponseWithSignature,
+	result *aggtypes.ValidatedResponse) error {
+	a.received = response
+	*result = aggtypes.ValidatedResponse{
+		Data:                  response.Data,
+		Err:                   a.err,
+		Hash:                  response.RequestData.Hash(),
+		NonSignersPubkeysG1:   []*bls.G1Point{a.keyPair.GetPubKeyG1()},
+		GroupApksG1:           []*bls.G1Point{a.keyPair.GetPubKeyG1()},
+		SignersApkG2:          a.keyPair.GetPubKeyG2(),
+		SignersAggSigG1:       response.Signature,
+		GroupApkIndices:       []uint32{1, 2},
+		TotalStakeIndices:     []uint32{3},
+		NonSignerStakeIndices: [][]uint32{{4, 5}, {6}},
+	}
+	return nil
+}
+
+func (a *echoAggregator) CollectResponseSignatures(responses []*aggtypes.ResponseWithSignature,
+	results *[]aggtypes.ValidatedResponse) error {
+	for _, response := range responses {
+		var result aggtypes.ValidatedResponse
+		if err := a.CollectResponseSignature(response, &result); err != nil {
+			return err
+		}
+		*results = append(*results, result)
+	}
+	return nil
+}
+
+func (a *echoAggregator) GetTaskStatus(_ []byte, reply *aggtypes.TaskStatus) error {
+	*reply = aggtypes.TaskStatus{State: aggtypes.TaskStatePending, ResponsesCount: 3}
+	return nil
+}
+
+func (a *echoAggregator) ListTasks(_ struct{}, reply *[]aggtypes.TaskInfo) error {
+	*reply = []aggtypes.TaskInfo{{
+		RequestHash:          []byte("hash"),
+		Request:              avsitypes.DVSRequest{Data: []byte("request"), ChainId: 1},
+		ChainID:              1,
+		BlockNumber:          100,
+		GroupNumbers:         []uint32{0},
+		ThresholdPercentages: []uint32{67},
+		Deadline:             time.Unix(0, 42),
+		TotalStakePerGroup:   map[uint32]*big.Int{0: big.NewInt(300)},
+		Signers:              [][32]byte{{1}},
+		Digests: []aggtypes.DigestInfo{{
+			Digest:        [32]byte{9},
+			Signers:       [][32]byte{{1}},
+			StakePerGroup: map[uint32]*big.Int{0: big.NewInt(100)},
+		}},
+		MissingOperators: [][32]byte{{2}, {3}},
+	}}
+	return nil
+}
+
+func (a *echoAggregator) GetTaskResult(_ []byte, _ *aggtypes.ValidatedResponse) error {
+	return aggtypes.ErrTaskResultNotFound
+}
+
+func (a *echoAggregator) ListEvidence(query aggtypes.EvidenceQuery, reply *[]aggtypes.Evidence) error {
+	a.evidenceQuery = query
+	*reply = a.evidence
+	return nil
+}
+
+func (a *echoAggregator) IsRunning() bool {
+	return true
+}
+
+func startTestServer(t *testing.T, aggregator Service) *AggregatorGRPCClient {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	server := NewGRPCServer(aggregator)
+	go server.Serve(ln) //nolint:errcheck
+	t.Cleanup(server.Stop)
+
+	client, err := NewAggregatorGRPCClient(ln.Addr().String(), log.NewNopLogger())
+	require.NoError(t, err)
+	t.Cleanup(func() { client.Close() })
+	return client
+}
+
+func testResponse(t *testing.T) (*aggtypes.ResponseWithSignature, *bls.KeyPair) {
+	keyPair, err := bls.GenRandomBlsKeys()
+	require.NoError(t, err)
+
+	data := []byte("response")
+	digest := [32]byte(crypto.Keccak256(data))
+	return &aggtypes.ResponseWithSignature{
+		Data:       data,
+		Digest:     digest,
+		Signature:  keyPair.SignMessage(digest),
+		OperatorID: [32]byte(crypto.Keccak256([]byte("operator"))),
+		RequestData: avsitypes.DVSRequest{
+			Data:                      []byte("request"),
+			Height:                    100,
+			ChainId:                   1,
+			GroupNumbers:              []uint32{0, 1},
+			GroupThresholdPercentages: []uint32{60, 70},
+		},
+	}, keyPair
+}
+
+func TestCollectResponseSignature(t *testing.T) {
+	response, keyPair := testResponse(t)
+	aggregator := &echoAggregator{keyPair: keyPair}
+	client := startTestServer(t, aggregator)
+
+	resultCh := make(chan aggtypes.ValidatedResponse, 1)
+	require.NoError(t, client.CollectResponseSignature(context.Background(), response, resultCh))
+
+	require.Equal(t, response.Digest, aggregator.received.Digest)
+	require.Equal(t, response.OperatorID, aggregator.received.OperatorID)
+	require.Equal(t, response.RequestData.Hash(), aggregator.received.RequestData.Hash())
+	require.True(t, response.Signature.Equal(aggregator.received.Signature.G1Affine))
+
+	result := <-resultCh
+	require.Nil(t, result.Err)
+	require.Equal(t, response.Data, result.Data)
+	require.Equal(t, []byte(response.RequestData.Hash()), result.Hash)
+	require.Equal(t, keyPair.GetPubKeyG1().Serialize(), result.NonSignersPubkeysG1[0].Serialize())
+	require.Equal(t, keyPair.GetPubKeyG1().Serialize(), result.GroupApksG1[0].Serialize())
+	require.Equal(t, keyPair.GetPubKeyG2().Serialize(), result.SignersApkG2.Serialize())
+	require.Equal(t, response.Signature.Serialize(), result.SignersAggSigG1.Serialize())
+	require.Equal(t, []uint32{1, 2}, result.GroupApkIndices)
+	require.Equal(t, [][]uint32{{4, 5}, {6}}, result.NonSignerStakeIndices)
+}
+
+func TestCollectResponseSignatureAggregatorError(t *testing.T) {
+	response, keyPair := testResponse(t)
+	client := startTestServer(t, &echoAggregator{
+		keyPair: keyPair,
+		err:     &rpctypes.RPCError{Code: 32000, Message: "aggregation failed"},
+	})
+
+	resultCh := make(chan aggtypes.ValidatedResponse, 1)
+	err := client.CollectResponseSignature(context.Background(), response, resultCh)
+	require.ErrorContains(t, err, "aggregation failed")
+	require.Empty(t, resultCh)
+}
+
+func TestCollectResponseSignatures(t *testing.T) {
+	first, keyPair := testResponse(t)
+	second, _ := testResponse(t)
+	second.Data = []byte("second")
+	client := startTestServer(t, &echoAggregator{keyPair: keyPair})
+
+	resultsCh := make(chan []aggtypes.ValidatedResponse, 1)
+	require.NoError(t, client.CollectResponseSignatures(context.Background(), []*aggtypes.ResponseWithSignature{first, second}, resultsCh))
+
+	results := <-resultsCh
+	require.Len(t, results, 2)
+	require.Equal(t, first.Data, results[0].Data)
+	require.Equal(t, second.Data, results[1].Data)
+	require.Equal(t, second.Signature.Serialize(), results[1].SignersAggSigG1.Serialize())
+}
+
+func TestHealthCheckAndTaskStatus(t *testing.T) {
+	client := startTestServer(t, &echoAggregator{})
+
+	healthy, err := client.HealthCheck()
+	require.NoError(t, err)
+	require.True(t, healthy)
+
+	status, err := client.TaskStatus([]byte("hash"))
+	require.NoError(t, err)
+	require.Equal(t, aggtypes.TaskStatePending, status.State)
+	require.Equal(t, 3, status.ResponsesCount)
+	require.Nil(t, status.Result)
+}
+
+func TestResponseWithSignatureFromProtoRejectsMalformed(t *testing.T) {
+	response, _ := testResponse(t)
+
+	pb := ResponseWithSignatureToProto(response)
+	pb.Digest = pb.Digest[:31]
+	_, err := ResponseWithSignatureFromProto(pb)
+	require.Error(t, err)
+
+	pb = ResponseWithSignatureToProto(response)
+	pb.Signature = pb.Signature[:10]
+	_, err = ResponseWithSignatureFromProto(pb)
+	require.Error(t, err)
+
+	pb = ResponseWithSignatureToProto(response)
+	pb.RequestData = nil
+	_, err = ResponseWithSignatureFromProto(pb)
+	require.Error(t, err)
+}
+
+func TestListTasksAndTaskResult(t *testing.T) {
+	aggregator := &echoAggregator{}
+	client := startTestServer(t, aggregator)
+
+	var expected []aggtypes.TaskInfo
+	require.NoError(t, aggregator.ListTasks(struct{}{}, &expected))
+
+	tasks, err := client.ListTasks()
+	require.NoError(t, err)
+	require.Len(t, tasks, 1)
+	require.Equal(t, expected[0].Request.Hash(), tasks[0].Request.Hash())
+	tasks[0].Request = expected[0].Request
+	require.Equal(t, expected[0], tasks[0])
+
+	_, err = client.TaskResult([]byte("hash"))
+	require.ErrorIs(t, err, aggtypes.ErrTaskResultNotFound)
+}
+
+func TestListEvidence(t *testing.T) {
+	first, keyPair := testResponse(t)
+	second := *first
+	second.Data = []byte("other response")
+	second.Digest = [32]byte(crypto.Keccak256(second.Data))
+	second.Signature = keyPair.SignMessage(second.Digest)
+
+	evidence := aggtypes.Evidence{
+		RequestHash: first.RequestData.Hash(),
+		OperatorID:  first.OperatorID,
+		First:       *first,
+		Second:      second,
+		DetectedAt:  time.Unix(0, 42),
+	}
+	aggregator := &echoAggregator{evidence: []aggtypes.Evidence{evidence}}
+	client := startTestServer(t, aggregator)
+
+	query := aggtypes.EvidenceQuery{OperatorID: first.OperatorID[:], RequestHash: evidence.RequestHash}
+	listed, err := client.ListEvidence(query)
+	require.NoError(t, err)
+	require.Equal(t, query, aggregator.evidenceQuery)
+	require.Len(t, listed, 1)
+	require.Equal(t, evidence.OperatorID, listed[0].OperatorID)
+	require.Equal(t, evidence.RequestHash, listed[0].RequestHash)
+	require.Equal(t, first.Digest, listed[0].First.Digest)
+	require.Equal(t, second.Digest, listed[0].Second.Digest)
+	require.Equal(t, second.Signature.Serialize(), listed[0].Second.Signature.Serialize())
+	require.True(t, evidence.DetectedAt.Equal(listed[0].DetectedAt))
+
+	_, err = client.ListEvidence(aggtypes.EvidenceQuery{OperatorID: []byte("short")})
+	require.Error(t, err)
+}
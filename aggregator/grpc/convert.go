@@ -0,0 +1,352 @@
+package agggrpc
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/0xPellNetwork/pelldvs-libs/crypto/bls"
+	aggtypes "github.com/0xPellNetwork/pelldvs/aggregator/types"
+	rpctypes "github.com/0xPellNetwork/pelldvs/rpc/jsonrpc/types"
+)
+
+const (
+	g1PointSize = 64
+	g2PointSize = 128
+)
+
+// ResponseWithSignatureToProto converts an operator response to its wire format
+func ResponseWithSignatureToProto(response *aggtypes.ResponseWithSignature) *ResponseWithSignature {
+	requestData := response.RequestData
+	pb := &ResponseWithSignature{
+		Data:              response.Data,
+		Digest:            response.Digest[:],
+		OperatorId:        response.OperatorID[:],
+		RequestData:       &requestData,
+		OperatorSignature: response.OperatorSignature,
+	}
+	if response.Signature != nil && response.Signature.G1Point != nil {
+		pb.Signature = response.Signature.Serialize()
+	}
+	return pb
+}
+
+// ResponseWithSignatureFromProto converts an operator response from its wire format,
+// rejecting digests, operator IDs and signatures of the wrong size
+func ResponseWithSignatureFromProto(pb *ResponseWithSignature) (*aggtypes.ResponseWithSignature, error) {
+	if pb == nil {
+		return nil, fmt.Errorf("response is missing")
+	}
+	if len(pb.Digest) != 32 {
+		return nil, fmt.Errorf("invalid digest size %d, expected 32", len(pb.Digest))
+	}
+	if len(pb.OperatorId) != 32 {
+		return nil, fmt.Errorf("invalid operator ID size %d, expected 32", len(pb.OperatorId))
+	}
+	if pb.RequestData == nil {
+		return nil, fmt.Errorf("request data is missing")
+	}
+
+	response := &aggtypes.ResponseWithSignature{
+		Data:              pb.Data,
+		RequestData:       *pb.RequestData,
+		OperatorSignature: pb.OperatorSignature,
+	}
+	copy(response.Digest[:], pb.Digest)
+	copy(response.OperatorID[:], pb.OperatorId)
+
+	if len(pb.Signature) > 0 {
+		point, err := g1PointFromBytes(pb.Signature)
+		if err != nil {
+			return nil, fmt.Errorf("invalid signature: %v", err)
+		}
+		response.Signature = &bls.Signature{G1Point: point}
+	}
+	return response, nil
+}
+
+// ValidatedResponseToProto converts an aggregated result to its wire format
+func ValidatedResponseToProto(response *aggtypes.ValidatedResponse) *ValidatedResponse {
+	pb := &ValidatedResponse{
+		Data:                        response.Data,
+		Hash:                        response.Hash,
+		NonSignersPubkeysG1:         make([][]byte, 0, len(response.NonSignersPubkeysG1)),
+		GroupApksG1:                 make([][]byte, 0, len(response.GroupApksG1)),
+		NonSignerGroupBitmapIndices: response.NonSignerGroupBitmapIndices,
+		GroupApkIndices:             response.GroupApkIndices,
+		TotalStakeIndices:           response.TotalStakeIndices,
+		NonSignerStakeIndices:       make([]*NonSignerStakeIndex, 0, len(response.NonSignerStakeIndices)),
+		NotIncluded:                 response.NotIncluded,
+	}
+	if response.Err != nil {
+		pb.Error = &Error{
+			Code:    int32(response.Err.Code),
+			Message: response.Err.Message,
+			Data:    response.Err.Data,
+		}
+	}
+	for _, pubkey := range response.NonSignersPubkeysG1 {
+		pb.NonSignersPubkeysG1 = append(pb.NonSignersPubkeysG1, pubkey.Serialize())
+	}
+	for _, apk := range response.GroupApksG1 {
+		pb.GroupApksG1 = append(pb.GroupApksG1, apk.Serialize())
+	}
+	if response.SignersApkG2 != nil {
+		pb.SignersApkG2 = response.SignersApkG2.Serialize()
+	}
+	if response.SignersAggSigG1 != nil && response.SignersAggSigG1.G1Point != nil {
+		pb.SignersAggSigG1 = response.SignersAggSigG1.Serialize()
+	}
+	for _, indices := range response.NonSignerStakeIndices {
+		pb.NonSignerStakeIndices = append(pb.NonSignerStakeIndices, &NonSignerStakeIndex{Indices: indices})
+	}
+	return pb
+}
+
+// ValidatedResponseFromProto converts an aggregated result from its wire format
+func ValidatedResponseFromProto(pb *ValidatedResponse) (*aggtypes.ValidatedResponse, error) {
+	if pb == nil {
+		return nil, fmt.Errorf("validated response is missing")
+	}
+
+	response := &aggtypes.ValidatedResponse{
+		Data:                        pb.Data,
+		Hash:                        pb.Hash,
+		NonSignersPubkeysG1:         make([]*bls.G1Point, 0, len(pb.NonSignersPubkeysG1)),
+		GroupApksG1:                 make([]*bls.G1Point, 0, len(pb.GroupApksG1)),
+		NonSignerGroupBitmapIndices: pb.NonSignerGroupBitmapIndices,
+		GroupApkIndices:             pb.GroupApkIndices,
+		TotalStakeIndices:           pb.TotalStakeIndices,
+		NonSignerStakeIndices:       make([][]uint32, 0, len(pb.NonSignerStakeIndices)),
+		NotIncluded:                 pb.NotIncluded,
+	}
+	if pb.Error != nil {
+		response.Err = &rpctypes.RPCError{
+			Code:    int(pb.Error.Code),
+			Message: pb.Error.Message,
+			Data:    pb.Error.Data,
+		}
+	}
+	for _, data := range pb.NonSignersPubkeysG1 {
+		pubkey, err := g1PointFromBytes(data)
+		if err != nil {
+			return nil, fmt.Errorf("invalid non signer public key: %v", err)
+		}
+		response.NonSignersPubkeysG1 = append(response.NonSignersPubkeysG1, pubkey)
+	}
+	for _, data := range pb.GroupApksG1 {
+		apk, err := g1PointFromBytes(data)
+		if err != nil {
+			return nil, fmt.Errorf("invalid group aggregated public key: %v", err)
+		}
+		response.GroupApksG1 = append(response.GroupApksG1, apk)
+	}
+	if len(pb.SignersApkG2) > 0 {
+		apk, err := g2PointFromBytes(pb.SignersApkG2)
+		if err != nil {
+			return nil, fmt.Errorf("invalid signers aggregated public key: %v", err)
+		}
+		response.SignersApkG2 = apk
+	}
+	if len(pb.SignersAggSigG1) > 0 {
+		point, err := g1PointFromBytes(pb.SignersAggSigG1)
+		if err != nil {
+			return nil, fmt.Errorf("invalid signers aggregated signature: %v", err)
+		}
+		response.SignersAggSigG1 = &bls.Signature{G1Point: point}
+	}
+	for _, indices := range pb.NonSignerStakeIndices {
+		response.NonSignerStakeIndices = append(response.NonSignerStakeIndices, indices.GetIndices())
+	}
+	return response, nil
+}
+
+// TaskStatusToProto converts a task status to its wire format
+func TaskStatusToProto(status *aggtypes.TaskStatus) *ResponseTaskStatus {
+	pb := &ResponseTaskStatus{
+		State:          TaskState(status.State),
+		ResponsesCount: uint32(status.ResponsesCount),
+	}
+	if status.Result != nil {
+		pb.Result = ValidatedResponseToProto(status.Result)
+	}
+	return pb
+}
+
+// TaskStatusFromProto converts a task status from its wire format
+func TaskStatusFromProto(pb *ResponseTaskStatus) (*aggtypes.TaskStatus, error) {
+	status := &aggtypes.TaskStatus{
+		State:          aggtypes.TaskState(pb.State),
+		ResponsesCount: int(pb.ResponsesCount),
+	}
+	if pb.Result != nil {
+		result, err := ValidatedResponseFromProto(pb.Result)
+		if err != nil {
+			return nil, err
+		}
+		status.Result = result
+	}
+	return status, nil
+}
+
+// TaskInfoToProto converts the description of an in-flight task to its wire format
+func TaskInfoToProto(info *aggtypes.TaskInfo) *TaskInfo {
+	request := info.Request
+	pb := &TaskInfo{
+		RequestHash:          info.RequestHash,
+		Request:              &request,
+		ChainId:              info.ChainID,
+		BlockNumber:          info.BlockNumber,
+		GroupNumbers:         info.GroupNumbers,
+		ThresholdPercentages: info.ThresholdPercentages,
+		Deadline:             info.Deadline.UnixNano(),
+		TotalStakePerGroup:   groupStakesToProto(info.TotalStakePerGroup),
+		Signers:              operatorIDsToProto(info.Signers),
+		Digests:              make([]*DigestInfo, 0, len(info.Digests)),
+		MissingOperators:     operatorIDsToProto(info.MissingOperators),
+	}
+	for _, digest := range info.Digests {
+		pb.Digests = append(pb.Digests, &DigestInfo{
+			Digest:        append([]byte{}, digest.Digest[:]...),
+			Signers:       operatorIDsToProto(digest.Signers),
+			StakePerGroup: groupStakesToProto(digest.StakePerGroup),
+		})
+	}
+	return pb
+}
+
+// TaskInfoFromProto converts the description of an in-flight task from its wire format
+func TaskInfoFromProto(pb *TaskInfo) (*aggtypes.TaskInfo, error) {
+	if pb.Request == nil {
+		return nil, fmt.Errorf("request is missing")
+	}
+
+	info := &aggtypes.TaskInfo{
+		RequestHash:          pb.RequestHash,
+		Request:              *pb.Request,
+		ChainID:              pb.ChainId,
+		BlockNumber:          pb.BlockNumber,
+		GroupNumbers:         pb.GroupNumbers,
+		ThresholdPercentages: pb.ThresholdPercentages,
+		Deadline:             time.Unix(0, pb.Deadline),
+		Digests:              make([]aggtypes.DigestInfo, 0, len(pb.Digests)),
+	}
+
+	var err error
+	if info.TotalStakePerGroup, err = groupStakesFromProto(pb.TotalStakePerGroup); err != nil {
+		return nil, err
+	}
+	if info.Signers, err = operatorIDsFromProto(pb.Signers); err != nil {
+		return nil, err
+	}
+	if info.MissingOperators, err = operatorIDsFromProto(pb.MissingOperators); err != nil {
+		return nil, err
+	}
+	for _, digest := range pb.Digests {
+		if len(digest.Digest) != 32 {
+			return nil, fmt.Errorf("invalid digest size %d, expected 32", len(digest.Digest))
+		}
+		digestInfo := aggtypes.DigestInfo{}
+		copy(digestInfo.Digest[:], digest.Digest)
+		if digestInfo.Signers, err = operatorIDsFromProto(digest.Signers); err != nil {
+			return nil, err
+		}
+		if digestInfo.StakePerGroup, err = groupStakesFromProto(digest.StakePerGroup); err != nil {
+			return nil, err
+		}
+		info.Digests = append(info.Digests, digestInfo)
+	}
+	return info, nil
+}
+
+// EvidenceToProto converts equivocation evidence to its wire format
+func EvidenceToProto(evidence *aggtypes.Evidence) *Evidence {
+	return &Evidence{
+		RequestHash: evidence.RequestHash,
+		First:       ResponseWithSignatureToProto(&evidence.First),
+		Second:      ResponseWithSignatureToProto(&evidence.Second),
+		DetectedAt:  evidence.DetectedAt.UnixNano(),
+	}
+}
+
+// EvidenceFromProto converts equivocation evidence from its wire format,
+// rejecting evidence whose responses come from different operators
+func EvidenceFromProto(pb *Evidence) (*aggtypes.Evidence, error) {
+	first, err := ResponseWithSignatureFromProto(pb.First)
+	if err != nil {
+		return nil, fmt.Errorf("invalid first response: %v", err)
+	}
+	second, err := ResponseWithSignatureFromProto(pb.Second)
+	if err != nil {
+		return nil, fmt.Errorf("invalid second response: %v", err)
+	}
+	if first.OperatorID != second.OperatorID {
+		return nil, fmt.Errorf("responses come from different operators")
+	}
+	return &aggtypes.Evidence{
+		RequestHash: pb.RequestHash,
+		OperatorID:  first.OperatorID,
+		First:       *first,
+		Second:      *second,
+		DetectedAt:  time.Unix(0, pb.DetectedAt),
+	}, nil
+}
+
+func operatorIDsToProto(operatorIDs [][32]byte) [][]byte {
+	pb := make([][]byte, 0, len(operatorIDs))
+	for _, operatorID := range operatorIDs {
+		pb = append(pb, append([]byte{}, operatorID[:]...))
+	}
+	return pb
+}
+
+func operatorIDsFromProto(pb [][]byte) ([][32]byte, error) {
+	operatorIDs := make([][32]byte, 0, len(pb))
+	for _, data := range pb {
+		if len(data) != 32 {
+			return nil, fmt.Errorf("invalid operator ID size %d, expected 32", len(data))
+		}
+		var operatorID [32]byte
+		copy(operatorID[:], data)
+		operatorIDs = append(operatorIDs, operatorID)
+	}
+	return operatorIDs, nil
+}
+
+func groupStakesToProto(stakes map[uint32]*big.Int) []*GroupStake {
+	pb := make([]*GroupStake, 0, len(stakes))
+	for groupNumber, stake := range stakes {
+		pb = append(pb, &GroupStake{GroupNumber: groupNumber, Stake: stake.String()})
+	}
+	sort.Slice(pb, func(i, j int) bool {
+		return pb[i].GroupNumber < pb[j].GroupNumber
+	})
+	return pb
+}
+
+func groupStakesFromProto(pb []*GroupStake) (map[uint32]*big.Int, error) {
+	stakes := make(map[uint32]*big.Int, len(pb))
+	for _, groupStake := range pb {
+		stake, ok := new(big.Int).SetString(groupStake.Stake, 10)
+		if !ok {
+			return nil, fmt.Errorf("invalid stake %q of group %d", groupStake.Stake, groupStake.GroupNumber)
+		}
+		stakes[groupStake.GroupNumber] = stake
+	}
+	return stakes, nil
+}
+
+func g1PointFromBytes(data []byte) (*bls.G1Point, error) {
+	if len(data) != g1PointSize {
+		return nil, fmt.Errorf("invalid G1 point size %d, expected %d", len(data), g1PointSize)
+	}
+	return new(bls.G1Point).Deserialize(data), nil
+}
+
+func g2PointFromBytes(data []byte) (*bls.G2Point, error) {
+	if len(data) != g2PointSize {
+		return nil, fmt.Errorf("invalid G2 point size %d, expected %d", len(data), g2PointSize)
+	}
+	return new(bls.G2Point).Deserialize(data), nil
+}
@@ -0,0 +1,142 @@
+package rpc
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/0xPellNetwork/pelldvs-interactor/types"
+	avsitypes "github.com/0xPellNetwork/pelldvs/avsi/types"
+	"github.com/0xPellNetwork/pelldvs/rpc/core/errcode"
+)
+
+// Reasons for which a submission is rejected before it joins a task, used
+// as the reason label of SubmissionsRejected.
+const (
+	rejectReasonTooManyTasks      = "too_many_tasks"
+	rejectReasonTooManyChainTasks = "too_many_chain_tasks"
+	rejectReasonRequestTooLarge   = "request_too_large"
+	rejectReasonTooManyGroups     = "too_many_groups"
+	rejectReasonNotGroupMember    = "not_group_member"
+	rejectReasonShuttingDown      = "shutting_down"
+	rejectReasonBatchTooLarge     = "batch_too_large"
+)
+
+// admissionError rejects a submission before it is admitted to a task. It
+// is returned to the operator with its errcode rather than failing the call.
+type admissionError struct {
+	code   int
+	reason string
+	err    error
+}
+
+func (e *admissionError) Error() string {
+	return e.err.Error()
+}
+
+// admissionLimits bounds the requests the aggregator creates tasks for.
+// A zero limit is not enforced.
+type admissionLimits struct {
+	maxConcurrentTasks         int
+	maxConcurrentTasksPerChain int
+	maxRequestDataSize         int
+	maxGroupNumbers            int
+	maxBatchSize               int
+}
+
+// checkRequest rejects requests whose data or group count exceed the limits.
+// It needs no chain read and runs before the task of the request is looked up.
+func (ra *AggregatorRPCServer) checkRequest(request avsitypes.DVSRequest) error {
+	if limit := ra.limits.maxRequestDataSize; limit > 0 && len(request.Data) > limit {
+		return &admissionError{
+			code:   errcode.RequestTooLarge,
+			reason: rejectReasonRequestTooLarge,
+			err:    fmt.Errorf("request data size %d exceeds the limit of %d bytes", len(request.Data), limit),
+		}
+	}
+	if limit := ra.limits.maxGroupNumbers; limit > 0 && len(request.GroupNumbers) > limit {
+		return &admissionError{
+			code:   errcode.TooManyGroups,
+			reason: rejectReasonTooManyGroups,
+			err:    fmt.Errorf("request has %d groups, more than the limit of %d", len(request.GroupNumbers), limit),
+		}
+	}
+	return nil
+}
+
+// errShuttingDown refuses the tasks of requests arriving while the
+// aggregator is stopping
+var errShuttingDown = &admissionError{
+	code:   errcode.ShuttingDown,
+	reason: rejectReasonShuttingDown,
+	err:    errors.New("aggregator is shutting down"),
+}
+
+// reserveTask takes a slot for a new task of the chain, failing when the
+// aggregator is stopping or the global or per-chain limit on concurrent
+// tasks is reached. The slot is
+// taken before the chain reads of the task and released when it is
+// finalized or could not be created.
+func (ra *AggregatorRPCServer) reserveTask(chainID int64) error {
+	ra.tasksMutex.Lock()
+	defer ra.tasksMutex.Unlock()
+
+	if ra.shuttingDown.Load() {
+		return errShuttingDown
+	}
+	if limit := ra.limits.maxConcurrentTasks; limit > 0 && ra.activeTasks >= limit {
+		return &admissionError{
+			code:   errcode.TooManyTasks,
+			reason: rejectReasonTooManyTasks,
+			err:    fmt.Errorf("too many concurrent tasks, limit is %d", limit),
+		}
+	}
+	if limit := ra.limits.maxConcurrentTasksPerChain; limit > 0 && ra.activeTasksPerChain[chainID] >= limit {
+		return &admissionError{
+			code:   errcode.TooManyTasks,
+			reason: rejectReasonTooManyChainTasks,
+			err:    fmt.Errorf("too many concurrent tasks for chain %d, limit is %d", chainID, limit),
+		}
+	}
+	ra.countTaskLocked(chainID)
+	return nil
+}
+
+// countTaskLocked counts a task of the chain as active. Callers must hold tasksMutex.
+func (ra *AggregatorRPCServer) countTaskLocked(chainID int64) {
+	ra.activeTasks++
+	ra.activeTasksPerChain[chainID]++
+}
+
+// releaseTaskLocked frees the slot of a task of the chain. Callers must hold tasksMutex.
+func (ra *AggregatorRPCServer) releaseTaskLocked(chainID int64) {
+	ra.activeTasks--
+	ra.activeTasksPerChain[chainID]--
+	if ra.activeTasksPerChain[chainID] <= 0 {
+		delete(ra.activeTasksPerChain, chainID)
+	}
+}
+
+// releaseTask frees the slot of a task of the chain
+func (ra *AggregatorRPCServer) releaseTask(chainID int64) {
+	ra.tasksMutex.Lock()
+	ra.releaseTaskLocked(chainID)
+	ra.tasksMutex.Unlock()
+}
+
+// checkMembership rejects operators that have no stake in any group of the
+// task at the request height
+func checkMembership(task *Task, operatorID types.OperatorID) error {
+	if state, ok := task.operatorsDvsStateDict[operatorID]; ok {
+		for _, groupNumber := range task.groupNumbers {
+			if _, ok := state.StakePerGroup[groupNumber]; ok {
+				return nil
+			}
+		}
+	}
+	return &admissionError{
+		code:   errcode.NotGroupMember,
+		reason: rejectReasonNotGroupMember,
+		err: fmt.Errorf("operator %x is not a member of groups %v at block %d",
+			operatorID, task.groupNumbers, task.blockNumber),
+	}
+}
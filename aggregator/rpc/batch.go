@@ -0,0 +1,115 @@
+package rpc
+
+import (
+	"fmt"
+
+	aggtypes "github.com/0xPellNetwork/pelldvs/aggregator/types"
+	cryptobls "github.com/0xPellNetwork/pelldvs/crypto/bls"
+	"github.com/0xPellNetwork/pelldvs/rpc/core/errcode"
+	rpctypes "github.com/0xPellNetwork/pelldvs/rpc/jsonrpc/types"
+)
+
+// CollectResponseSignatures processes the responses of an operator to several
+// requests submitted in a single call. The BLS signatures of the batch are
+// verified together, then every request is aggregated by its own task, the
+// tasks of requests at the same block and groups sharing their operator
+// snapshot. The results are returned in the order of the responses.
+func (ra *AggregatorRPCServer) CollectResponseSignatures(responses []*aggtypes.ResponseWithSignature,
+	results *[]aggtypes.ValidatedResponse) error {
+	ra.logger.Info("CollectResponseSignatures start", "responses", len(responses))
+	ra.metrics.BatchSize.Observe(float64(len(responses)))
+
+	subs := make([]*submission, len(responses))
+	for i, response := range responses {
+		subs[i] = &submission{taskID: ra.generateTaskID(response.RequestData), response: response}
+	}
+
+	if limit := ra.limits.maxBatchSize; limit > 0 && len(subs) > limit {
+		rejection := &admissionError{
+			code:   errcode.BatchTooLarge,
+			reason: rejectReasonBatchTooLarge,
+			err:    fmt.Errorf("batch of %d responses exceeds the limit of %d", len(subs), limit),
+		}
+		for _, sub := range subs {
+			ra.rejectSubmission(sub, rejection)
+		}
+	} else {
+		ra.collectBatch(subs)
+	}
+
+	*results = make([]aggtypes.ValidatedResponse, 0, len(subs))
+	for _, sub := range subs {
+		*results = append(*results, *sub.result)
+	}
+
+	ra.logger.Info("CollectResponseSignatures done", "responses", len(responses))
+	return nil
+}
+
+// collectBatch admits the submissions of a batch, verifies their signatures
+// together, adds them to their tasks and waits for the results
+func (ra *AggregatorRPCServer) collectBatch(subs []*submission) {
+	pending := make([]*submission, 0, len(subs))
+	for _, sub := range subs {
+		if err := ra.admitSubmission(sub); err != nil {
+			ra.logger.Error("Failed to admit operator response",
+				"taskID", sub.taskID, "operatorID", sub.response.OperatorID,
+				"error", err,
+			)
+			sub.result = ra.createErrorValidatedResponse(sub.taskID, &rpctypes.RPCError{
+				Code:    errcode.AggregationFailed,
+				Message: fmt.Sprintf("Failed to process operator response: %v", err),
+				Data:    sub.taskID,
+			})
+			continue
+		}
+		if sub.result == nil {
+			pending = append(pending, sub)
+		}
+	}
+
+	for _, sub := range ra.verifySubmissions(pending) {
+		ra.submitResponse(sub)
+	}
+	for _, sub := range subs {
+		ra.awaitResult(sub)
+	}
+}
+
+// verifySubmissions checks the BLS signatures of the submissions with
+// randomized batch verification and returns the ones that verify. The
+// others are answered with an invalid signature error.
+func (ra *AggregatorRPCServer) verifySubmissions(subs []*submission) []*submission {
+	verifier := cryptobls.NewBatchVerifier()
+	batched := make([]*submission, 0, len(subs))
+	for _, sub := range subs {
+		pubkeyG2, err := signerPubkey(sub.task, sub.response)
+		if err == nil {
+			pubkey := cryptobls.PubKey{G2Point: cryptobls.G2Point{G2Affine: pubkeyG2.G2Affine}}
+			err = verifier.Add(pubkey, sub.response.Digest[:], sub.response.Signature.Serialize())
+		}
+		if err != nil {
+			ra.rejectInvalidSignature(sub, err)
+			continue
+		}
+		batched = append(batched, sub)
+	}
+	if len(batched) == 0 {
+		return nil
+	}
+
+	ok, valid := verifier.Verify()
+	if !ok {
+		ra.metrics.BatchVerificationFailures.Add(1)
+	}
+
+	verified := make([]*submission, 0, len(batched))
+	for i, sub := range batched {
+		if !valid[i] {
+			ra.rejectInvalidSignature(sub, fmt.Errorf("signature does not match digest %x", sub.response.Digest))
+			continue
+		}
+		verified = append(verified, sub)
+	}
+	return verified
+}
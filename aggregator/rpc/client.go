@@ -14,6 +14,11 @@ import (
 const (
 	RPCHealthCheckMethod      = "AggregatorRPCServer.HealthCheck"
 	RPCServerAggregatorMethod = "AggregatorRPCServer.CollectResponseSignature"
+	RPCServerBatchMethod      = "AggregatorRPCServer.CollectResponseSignatures"
+	RPCTaskStatusMethod       = "AggregatorRPCServer.GetTaskStatus"
+	RPCListTasksMethod        = "AggregatorRPCServer.ListTasks"
+	RPCTaskResultMethod       = "AggregatorRPCServer.GetTaskResult"
+	RPCListEvidenceMethod     = "AggregatorRPCServer.ListEvidence"
 )
 
 // AggregatorRPCClient provides a client implementation of the Aggregator interface
@@ -23,6 +28,8 @@ type AggregatorRPCClient struct {
 	logger        log.Logger
 }
 
+var _ aggtypes.BatchAggregator = (*AggregatorRPCClient)(nil)
+
 // NewAggregatorRPCClient creates a new client instance connected to the specified address
 // establishing a connection to the aggregator RPC server
 func NewAggregatorRPCClient(address string, logger log.Logger) (*AggregatorRPCClient, error) {
@@ -43,10 +50,10 @@ func NewAggregatorRPCClient(address string, logger log.Logger) (*AggregatorRPCCl
 
 // CollectResponseSignature implements the Aggregator interface by forwarding the request
 // to the RPC server and receiving the validated response
-func (ra *AggregatorRPCClient) CollectResponseSignature(responseWithSignature *aggtypes.ResponseWithSignature,
+func (ra *AggregatorRPCClient) CollectResponseSignature(ctx context.Context,
+	responseWithSignature *aggtypes.ResponseWithSignature,
 	validatedResponseCh chan<- aggtypes.ValidatedResponse) error {
 	var result aggtypes.ValidatedResponse
-	ctx := context.Background()
 	client, err := ra.clientManager.GetClient(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to get RPC client: %v", err)
@@ -54,7 +61,7 @@ func (ra *AggregatorRPCClient) CollectResponseSignature(responseWithSignature *a
 	ra.logger.Info("AggregatorClient: Calling RPC method to collect responseWithSignature signature",
 		"ResponseWithSignature", responseWithSignature,
 	)
-	err = client.Call(RPCServerAggregatorMethod, responseWithSignature, &result)
+	err = callContext(ctx, client, RPCServerAggregatorMethod, responseWithSignature, &result)
 
 	if errors.Is(err, rpc.ErrShutdown) {
 		// If the client is shutdown, try to reconnect
@@ -63,7 +70,7 @@ func (ra *AggregatorRPCClient) CollectResponseSignature(responseWithSignature *a
 		if err != nil {
 			return fmt.Errorf("failed to get RPC client after shutdown: %v", err)
 		}
-		err = client.Call(RPCServerAggregatorMethod, responseWithSignature, &result)
+		err = callContext(ctx, client, RPCServerAggregatorMethod, responseWithSignature, &result)
 	}
 
 	if err != nil {
@@ -71,7 +78,7 @@ func (ra *AggregatorRPCClient) CollectResponseSignature(responseWithSignature *a
 	}
 
 	if result.Err != nil {
-		return fmt.Errorf("aggregator returned error: %v", result.Err)
+		return &aggtypes.AggregationError{Err: result.Err}
 	}
 
 	ra.logger.Info("AggregatorClient: Received validated responseWithSignature", "result", result)
@@ -80,6 +87,54 @@ func (ra *AggregatorRPCClient) CollectResponseSignature(responseWithSignature *a
 	return nil
 }
 
+// CollectResponseSignatures implements the BatchAggregator interface by forwarding
+// the responses to the RPC server in a single call
+func (ra *AggregatorRPCClient) CollectResponseSignatures(ctx context.Context,
+	responses []*aggtypes.ResponseWithSignature,
+	resultsCh chan<- []aggtypes.ValidatedResponse) error {
+	var results []aggtypes.ValidatedResponse
+	client, err := ra.clientManager.GetClient(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get RPC client: %v", err)
+	}
+	ra.logger.Info("AggregatorClient: Calling RPC method to collect batch of response signatures",
+		"responses", len(responses),
+	)
+	err = callContext(ctx, client, RPCServerBatchMethod, responses, &results)
+
+	if errors.Is(err, rpc.ErrShutdown) {
+		// If the client is shutdown, try to reconnect
+		ra.logger.Info("RPC client is shutdown, attempting to reconnect")
+		client, err = ra.clientManager.GetClient(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get RPC client after shutdown: %v", err)
+		}
+		err = callContext(ctx, client, RPCServerBatchMethod, responses, &results)
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to call aggregator RPC method: %v", err)
+	}
+	if len(results) != len(responses) {
+		return fmt.Errorf("aggregator returned %d results for %d responses", len(results), len(responses))
+	}
+
+	resultsCh <- results
+	return nil
+}
+
+// callContext calls the RPC method and waits for its reply until the context
+// is done. The reply of an abandoned call is discarded.
+func callContext(ctx context.Context, client *rpc.Client, method string, args any, reply any) error {
+	call := client.Go(method, args, reply, make(chan *rpc.Call, 1))
+	select {
+	case <-call.Done:
+		return call.Error
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
 // HealthCheck performs a health check on the aggregator service
 func (ra *AggregatorRPCClient) HealthCheck() (bool, error) {
 	var result bool
@@ -94,3 +149,69 @@ func (ra *AggregatorRPCClient) HealthCheck() (bool, error) {
 
 	return result, nil
 }
+
+// TaskStatus queries the state of the aggregation task of the request with the given hash
+func (ra *AggregatorRPCClient) TaskStatus(requestHash []byte) (*aggtypes.TaskStatus, error) {
+	var result aggtypes.TaskStatus
+	client, err := ra.clientManager.GetClient(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get RPC client: %v", err)
+	}
+
+	if err = client.Call(RPCTaskStatusMethod, requestHash, &result); err != nil {
+		return nil, fmt.Errorf("failed to call aggregator RPC method: %v", err)
+	}
+
+	return &result, nil
+}
+
+// ListTasks returns the in-flight aggregation tasks of the aggregator
+func (ra *AggregatorRPCClient) ListTasks() ([]aggtypes.TaskInfo, error) {
+	var result []aggtypes.TaskInfo
+	client, err := ra.clientManager.GetClient(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get RPC client: %v", err)
+	}
+
+	if err = client.Call(RPCListTasksMethod, struct{}{}, &result); err != nil {
+		return nil, fmt.Errorf("failed to call aggregator RPC method: %v", err)
+	}
+
+	return result, nil
+}
+
+// TaskResult returns the result of the finalized task of the request with the given hash
+func (ra *AggregatorRPCClient) TaskResult(requestHash []byte) (*aggtypes.ValidatedResponse, error) {
+	var result aggtypes.ValidatedResponse
+	client, err := ra.clientManager.GetClient(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get RPC client: %v", err)
+	}
+
+	err = client.Call(RPCTaskResultMethod, requestHash, &result)
+	// net/rpc only carries the error message over the wire
+	if err != nil && err.Error() == aggtypes.ErrTaskResultNotFound.Error() {
+		return nil, aggtypes.ErrTaskResultNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to call aggregator RPC method: %v", err)
+	}
+
+	return &result, nil
+}
+
+// ListEvidence returns the evidence of operators that signed conflicting
+// digests for a request, filtered by the query
+func (ra *AggregatorRPCClient) ListEvidence(query aggtypes.EvidenceQuery) ([]aggtypes.Evidence, error) {
+	var result []aggtypes.Evidence
+	client, err := ra.clientManager.GetClient(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get RPC client: %v", err)
+	}
+
+	if err = client.Call(RPCListEvidenceMethod, query, &result); err != nil {
+		return nil, fmt.Errorf("failed to call aggregator RPC method: %v", err)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,130 @@
+package rpc
+
+import (
+	"bufio"
+	"encoding/gob"
+	"io"
+	"net/rpc"
+	"sync"
+	"time"
+)
+
+// gobServerCodec is the gob codec net/rpc serves connections with, which
+// the package does not export
+type gobServerCodec struct {
+	rwc    io.ReadWriteCloser
+	dec    *gob.Decoder
+	enc    *gob.Encoder
+	encBuf *bufio.Writer
+	closed bool
+}
+
+func newGobServerCodec(conn io.ReadWriteCloser) *gobServerCodec {
+	buf := bufio.NewWriter(conn)
+	return &gobServerCodec{
+		rwc:    conn,
+		dec:    gob.NewDecoder(conn),
+		enc:    gob.NewEncoder(buf),
+		encBuf: buf,
+	}
+}
+
+func (c *gobServerCodec) ReadRequestHeader(r *rpc.Request) error {
+	return c.dec.Decode(r)
+}
+
+func (c *gobServerCodec) ReadRequestBody(body any) error {
+	return c.dec.Decode(body)
+}
+
+func (c *gobServerCodec) WriteResponse(r *rpc.Response, body any) error {
+	if err := c.enc.Encode(r); err != nil {
+		if c.encBuf.Flush() == nil {
+			c.Close()
+		}
+		return err
+	}
+	if err := c.enc.Encode(body); err != nil {
+		if c.encBuf.Flush() == nil {
+			c.Close()
+		}
+		return err
+	}
+	return c.encBuf.Flush()
+}
+
+func (c *gobServerCodec) Close() error {
+	if c.closed {
+		return nil
+	}
+	c.closed = true
+	return c.rwc.Close()
+}
+
+// trackedServerCodec counts a call from the moment its request header is
+// read until its response is written. net/rpc answers every call whose
+// header was read exactly once, even when the call fails.
+type trackedServerCodec struct {
+	rpc.ServerCodec
+	calls *callTracker
+}
+
+func (c *trackedServerCodec) ReadRequestHeader(r *rpc.Request) error {
+	if err := c.ServerCodec.ReadRequestHeader(r); err != nil {
+		return err
+	}
+	c.calls.add()
+	return nil
+}
+
+func (c *trackedServerCodec) WriteResponse(r *rpc.Response, body any) error {
+	defer c.calls.done()
+	return c.ServerCodec.WriteResponse(r, body)
+}
+
+// callTracker counts the calls in flight. Unlike a sync.WaitGroup, it can be
+// waited on with a deadline while new calls keep starting.
+type callTracker struct {
+	mtx   sync.Mutex
+	count int
+	idle  chan struct{} // closed once count drops to zero
+}
+
+func (t *callTracker) add() {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+	if t.count == 0 {
+		t.idle = make(chan struct{})
+	}
+	t.count++
+}
+
+func (t *callTracker) done() {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+	t.count--
+	if t.count == 0 {
+		close(t.idle)
+	}
+}
+
+// wait waits until no call is in flight or the deadline passes, and reports
+// whether the calls were all answered
+func (t *callTracker) wait(deadline time.Time) bool {
+	t.mtx.Lock()
+	if t.count == 0 {
+		t.mtx.Unlock()
+		return true
+	}
+	idle := t.idle
+	t.mtx.Unlock()
+
+	timer := time.NewTimer(time.Until(deadline))
+	defer timer.Stop()
+	select {
+	case <-idle:
+		return true
+	case <-timer.C:
+		return false
+	}
+}
@@ -0,0 +1,72 @@
+package rpc
+
+import (
+	"context"
+
+	aggtypes "github.com/0xPellNetwork/pelldvs/aggregator/types"
+)
+
+// LocalAggregator implements the Aggregator interface on top of an
+// aggregator server running in the same process. Operator responses are
+// handed to the server directly, with no network hop.
+type LocalAggregator struct {
+	server *AggregatorRPCServer
+}
+
+var _ aggtypes.BatchAggregator = (*LocalAggregator)(nil)
+
+// NewLocalAggregator creates an aggregator client for the given server
+func NewLocalAggregator(server *AggregatorRPCServer) *LocalAggregator {
+	return &LocalAggregator{server: server}
+}
+
+// CollectResponseSignature implements the Aggregator interface. Like the
+// remote clients, it returns an aggregation error when the server answers
+// with one instead of a validated response.
+func (la *LocalAggregator) CollectResponseSignature(ctx context.Context,
+	response *aggtypes.ResponseWithSignature,
+	validatedResponseCh chan<- aggtypes.ValidatedResponse) error {
+	var result aggtypes.ValidatedResponse
+	err := awaitServer(ctx, func() error {
+		return la.server.CollectResponseSignature(response, &result)
+	})
+	if err != nil {
+		return err
+	}
+	if result.Err != nil {
+		return &aggtypes.AggregationError{Err: result.Err}
+	}
+
+	validatedResponseCh <- result
+	return nil
+}
+
+// CollectResponseSignatures implements the BatchAggregator interface
+func (la *LocalAggregator) CollectResponseSignatures(ctx context.Context,
+	responses []*aggtypes.ResponseWithSignature,
+	resultsCh chan<- []aggtypes.ValidatedResponse) error {
+	var results []aggtypes.ValidatedResponse
+	err := awaitServer(ctx, func() error {
+		return la.server.CollectResponseSignatures(responses, &results)
+	})
+	if err != nil {
+		return err
+	}
+
+	resultsCh <- results
+	return nil
+}
+
+// awaitServer runs the server call until it returns or the context is done.
+// An abandoned call still completes in the server, which keeps aggregating
+// the responses of the other operators.
+func awaitServer(ctx context.Context, call func() error) error {
+	done := make(chan error, 1)
+	go func() { done <- call() }()
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,68 @@
+package rpc
+
+import (
+	"github.com/go-kit/kit/metrics"
+)
+
+const (
+	// MetricsSubsystem is a subsystem shared by all metrics exposed by this
+	// package.
+	MetricsSubsystem = "aggregator"
+)
+
+// Reasons for which an aggregation task fails, used as the reason label
+// of TasksFailed.
+const (
+	failureReasonCreateTask       = "create_task"
+	failureReasonNoSignatures     = "no_signatures"
+	failureReasonThresholdNotMet  = "threshold_not_met"
+	failureReasonAggregation      = "aggregation"
+	failureReasonInvalidAggregate = "invalid_aggregate"
+	failureReasonShuttingDown     = "shutting_down"
+)
+
+//go:generate go run ../../scripts/metricsgen -struct=Metrics
+
+// Metrics contains the prometheus metrics exposed by the aggregator.
+type Metrics struct {
+	// Number of aggregation tasks created.
+	TasksCreated metrics.Counter
+	// Number of aggregation tasks finalized, successfully or not.
+	TasksFinalized metrics.Counter
+	// Number of aggregation tasks that failed, by reason.
+	TasksFailed metrics.Counter `metrics_labels:"reason"`
+	// Number of operator signatures received.
+	SignaturesReceived metrics.Counter
+	// Number of operator signatures that passed verification.
+	SignaturesValid metrics.Counter
+	// Number of operator signatures that failed verification.
+	SignaturesInvalid metrics.Counter
+	// Number of operator submissions rejected because they were not signed by
+	// the operator they claim to come from.
+	SubmissionsUnauthenticated metrics.Counter
+	// Number of operator submissions rejected by admission control, by reason.
+	SubmissionsRejected metrics.Counter `metrics_labels:"reason"`
+	// Number of responses in the batches submitted by operators.
+	BatchSize metrics.Histogram `metrics_buckettype:"exp" metrics_bucketsizes:"1, 2, 8"`
+	// Number of batches whose BLS signatures failed batch verification and
+	// were verified one by one.
+	BatchVerificationFailures metrics.Counter
+	// Number of operator signatures received after their task was finalized.
+	SignaturesLate metrics.Counter
+	// Number of operators caught signing two different digests for a request.
+	EquivocationsDetected metrics.Counter
+	// Number of signers excluded from an aggregate that failed verification.
+	SignersExcluded metrics.Counter
+	// Time between the creation of a task and one digest reaching quorum.
+	TimeToQuorumSeconds metrics.Histogram `metrics_buckettype:"exprange" metrics_bucketsizes:"0.01, 60, 12"`
+	// Number of operators of a group that did not sign the aggregated digest.
+	NonSigners metrics.Histogram `metrics_labels:"group" metrics_buckettype:"exp" metrics_bucketsizes:"1, 2, 10"`
+	// Number of lookups served by the operator snapshot cache, by lookup.
+	SnapshotCacheHits metrics.Counter `metrics_labels:"lookup"`
+	// Number of lookups read from the chain on a snapshot cache miss, by lookup.
+	SnapshotCacheMisses metrics.Counter `metrics_labels:"lookup"`
+	// Number of operator snapshots held in the cache.
+	SnapshotCacheSize metrics.Gauge
+	// Duration of the DVSReader calls made by the aggregator.
+	DVSReaderCallDurationSeconds metrics.Histogram `metrics_labels:"method" metrics_buckettype:"exprange" metrics_bucketsizes:"0.001, 10, 10"`
+}
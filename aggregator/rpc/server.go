@@ -1,23 +1,31 @@
 package rpc
 
 import (
+	"bytes"
 	"context"
 	"encoding/hex"
 	"errors"
 	"fmt"
 	"math/big"
 	"net"
+	"net/http"
 	"net/rpc"
 	"sort"
+	"strconv"
 	"sync"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
 	interactorcfg "github.com/0xPellNetwork/pelldvs-interactor/config"
 	"github.com/0xPellNetwork/pelldvs-interactor/interactor/reader"
 	"github.com/0xPellNetwork/pelldvs-interactor/types"
 	"github.com/0xPellNetwork/pelldvs-libs/crypto/bls"
 	"github.com/0xPellNetwork/pelldvs-libs/log"
 	aggcfg "github.com/0xPellNetwork/pelldvs/aggregator/config"
+	agggrpc "github.com/0xPellNetwork/pelldvs/aggregator/grpc"
+	aggstore "github.com/0xPellNetwork/pelldvs/aggregator/store"
 	aggtypes "github.com/0xPellNetwork/pelldvs/aggregator/types"
 	avsitypes "github.com/0xPellNetwork/pelldvs/avsi/types"
 	"github.com/0xPellNetwork/pelldvs/config"
@@ -26,6 +34,35 @@ import (
 	rpctypes "github.com/0xPellNetwork/pelldvs/rpc/jsonrpc/types"
 )
 
+// resultPruneInterval is how often finalized results older than the
+// retention window are deleted from the task store
+const resultPruneInterval = time.Minute
+
+// resultCacheEvictInterval is how often expired results are dropped from
+// the finalized result cache
+const resultCacheEvictInterval = 10 * time.Second
+
+const readHeaderTimeout = 10 * time.Second
+
+const (
+	// restoreRetryBackoff is the delay before a task that could not be
+	// restored is tried again. It doubles on every further try, up to
+	// restoreRetryMaxBackoff.
+	restoreRetryBackoff    = time.Second
+	restoreRetryMaxBackoff = time.Minute
+)
+
+var (
+	errNoSignatures    = errors.New("no signatures to aggregate")
+	errThresholdNotMet = errors.New("stake thresholds not met for any digest")
+	// errInvalidAggregate is returned when the aggregated signature would
+	// not pass the on-chain signature check
+	errInvalidAggregate = errors.New("aggregated signature failed verification")
+	// errInvalidTask is returned when no task can ever be created for a
+	// request, unlike the errors reading the chain, which may be transient
+	errInvalidTask = errors.New("invalid task")
+)
+
 // NewAggregatorGRPCServer creates a new instance of the RPC server aggregator
 // initializing all required components and connections
 func NewAggregatorGRPCServer(
@@ -37,16 +74,51 @@ func NewAggregatorGRPCServer(
 	logger log.Logger,
 ) (*AggregatorRPCServer, error) {
 	timeout, _ := aggConfig.GetOperatorResponseTimeout(logger)
+	retention, _ := aggConfig.GetResultRetention(logger)
+	cacheTTL, _ := aggConfig.GetResultCacheTTL(logger)
+	drainTimeout, _ := aggConfig.GetShutdownDrainTimeout(logger)
 	tasksLocks := make(map[string]*sync.Mutex)
+
+	metrics := NopMetrics()
+	prometheusAddr := ""
+	if aggConfig.Prometheus {
+		metrics = PrometheusMetrics(cfg.Instrumentation.Namespace)
+		prometheusAddr = aggConfig.PrometheusListenAddr
+	}
+
+	db, err := config.DefaultDBProvider(&config.DBContext{ID: "aggregator", Config: cfg})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open aggregator task store: %v", err)
+	}
+
 	ra := &AggregatorRPCServer{
 		tasks:                   make(map[string]*Task),
+		results:                 make(map[string]*finalizedResult),
+		resultCacheTTL:          cacheTTL,
 		operatorResponseTimeout: timeout,
 		server:                  rpc.NewServer(),
 		rpcAddress:              aggConfig.AggregatorRPCServer,
+		grpcAddress:             aggConfig.AggregatorGRPCServer,
 		chainConfigs:            interactorConfig.ContractConfig.DVSConfigs,
 		tasksLocks:              tasksLocks,
 		logger:                  logger.With("module", "RPCServerAggregatorServer"),
 		dvsReader:               dvsReader,
+		metrics:                 metrics,
+		prometheusAddr:          prometheusAddr,
+		store:                   aggstore.NewTaskStore(db),
+		resultRetention:         retention,
+		allowUnauthenticated:    aggConfig.AllowUnauthenticated,
+		limits: admissionLimits{
+			maxConcurrentTasks:         aggConfig.MaxConcurrentTasks,
+			maxConcurrentTasksPerChain: aggConfig.MaxConcurrentTasksPerChain,
+			maxRequestDataSize:         aggConfig.MaxRequestDataSize,
+			maxGroupNumbers:            aggConfig.MaxGroupNumbers,
+			maxBatchSize:               aggConfig.MaxBatchSize,
+		},
+		activeTasksPerChain:  make(map[int64]int),
+		pendingRestores:      make(map[string]struct{}),
+		shutdownDrainTimeout: drainTimeout,
+		snapshots:            newSnapshotCache(aggConfig.OperatorSnapshotCacheSize),
 	}
 	ra.BaseService = *service.NewBaseService(nil, "AggregatorRPCServer", ra)
 
@@ -56,24 +128,74 @@ func NewAggregatorGRPCServer(
 }
 
 // OnStart initializes and starts the RPC server
+// restoring the tasks left in flight by a previous run,
 // registering handlers and beginning to accept connections
 func (ra *AggregatorRPCServer) OnStart() error {
+	if err := ra.restoreTasks(); err != nil {
+		return fmt.Errorf("failed to restore tasks: %v", err)
+	}
+
 	if err := ra.server.Register(ra); err != nil {
 		return fmt.Errorf("failed to register RPC handler: %v", err)
 	}
 
-	listener, err := net.Listen("tcp", ra.rpcAddress)
-	if err != nil {
-		return fmt.Errorf("unable to listen on address %s: %v", ra.rpcAddress, err)
+	// An aggregator embedded in a node may serve no address at all
+	if ra.rpcAddress != "" {
+		listener, err := net.Listen("tcp", ra.rpcAddress)
+		if err != nil {
+			return fmt.Errorf("unable to listen on address %s: %v", ra.rpcAddress, err)
+		}
+
+		ra.listener = listener
+		ra.logger.Info("RPC server started", "address", ra.rpcAddress)
+
+		go ra.acceptRPC(listener)
+	}
+
+	if ra.grpcAddress != "" {
+		grpcListener, err := net.Listen("tcp", ra.grpcAddress)
+		if err != nil {
+			if ra.listener != nil {
+				ra.listener.Close()
+			}
+			return fmt.Errorf("unable to listen on address %s: %v", ra.grpcAddress, err)
+		}
+
+		ra.grpcServer = agggrpc.NewGRPCServer(ra)
+		ra.logger.Info("gRPC server started", "address", ra.grpcAddress)
+
+		go func() {
+			if err := ra.grpcServer.Serve(grpcListener); err != nil {
+				ra.logger.Error("gRPC server stopped", "error", err)
+			}
+		}()
 	}
 
-	ra.listener = listener
-	ra.logger.Info("RPC server started", "address", ra.rpcAddress)
+	if ra.prometheusAddr != "" {
+		ra.prometheusSrv = ra.startPrometheusServer()
+	}
+
+	go ra.pruneResultsRoutine()
+	go ra.evictResultsRoutine()
 
-	go ra.server.Accept(listener)
 	return nil
 }
 
+// acceptRPC serves the net/rpc connections of the listener until it is
+// closed, tracking the calls in flight so that stopping waits for them
+func (ra *AggregatorRPCServer) acceptRPC(listener net.Listener) {
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			if !ra.shuttingDown.Load() {
+				ra.logger.Error("RPC server stopped accepting connections", "error", err)
+			}
+			return
+		}
+		go ra.server.ServeCodec(&trackedServerCodec{ServerCodec: newGobServerCodec(conn), calls: &ra.rpcCalls})
+	}
+}
+
 // IsRunning checks if the server is currently running
 // implementing the service interface requirement
 func (ra *AggregatorRPCServer) IsRunning() bool {
@@ -87,25 +209,523 @@ func (ra *AggregatorRPCServer) HealthCheck(_ struct{}, reply *bool) error {
 }
 
 // OnStop gracefully shuts down the RPC server
-// closing the network listener
+// refusing new tasks, draining the in-flight ones so that the operators
+// waiting on them get an answer, then closing the network listeners
 func (ra *AggregatorRPCServer) OnStop() {
+	deadline := time.Now().Add(ra.shutdownDrainTimeout)
+	ra.shuttingDown.Store(true)
+	ra.drainTasks(deadline)
+
 	if ra.listener != nil {
+		// let the net/rpc calls of the drained tasks write their answers
+		if !ra.rpcCalls.wait(deadline) {
+			ra.logger.Error("Timed out waiting for RPC calls to be answered", "timeout", ra.shutdownDrainTimeout)
+		}
 		ra.listener.Close()
 	}
+	if ra.grpcServer != nil {
+		ra.stopGRPCServer(deadline)
+	}
+	if ra.prometheusSrv != nil {
+		if err := ra.prometheusSrv.Shutdown(context.Background()); err != nil {
+			ra.logger.Error("Prometheus HTTP server Shutdown", "err", err)
+		}
+	}
+
+	// Leave the tasks that could not be drained in the store, they are
+	// restored on the next start
+	ra.tasksMutex.Lock()
+	for _, task := range ra.tasks {
+		task.timer.Stop()
+	}
+	ra.tasksMutex.Unlock()
+
+	// Closing the store waits for the writes in progress, and the tasks
+	// still being drained past the deadline no longer write to it
+	if err := ra.store.Close(); err != nil {
+		ra.logger.Error("Failed to close task store", "error", err)
+	}
+}
+
+// drainTasks finalizes the in-flight tasks that already reached quorum and
+// fails the others with a shutting down error, releasing their operators.
+// It gives up on the tasks still being drained at the deadline.
+func (ra *AggregatorRPCServer) drainTasks(deadline time.Time) {
+	ra.tasksMutex.RLock()
+	tasks := make([]*Task, 0, len(ra.tasks))
+	for _, task := range ra.tasks {
+		tasks = append(tasks, task)
+	}
+	ra.tasksMutex.RUnlock()
+	if len(tasks) == 0 {
+		return
+	}
+
+	ra.logger.Info("Draining in-flight tasks", "tasks", len(tasks), "deadline", deadline)
+	var wg sync.WaitGroup
+	for _, task := range tasks {
+		wg.Add(1)
+		go func(task *Task) {
+			defer wg.Done()
+			ra.drainTask(task)
+		}(task)
+	}
+
+	drained := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(drained)
+	}()
+
+	timer := time.NewTimer(time.Until(deadline))
+	defer timer.Stop()
+	select {
+	case <-drained:
+		ra.logger.Info("In-flight tasks drained", "tasks", len(tasks))
+	case <-timer.C:
+		ra.logger.Error("Timed out draining in-flight tasks", "timeout", ra.shutdownDrainTimeout)
+	}
+}
+
+// drainTask finalizes the task if one digest has reached quorum and fails
+// it otherwise
+func (ra *AggregatorRPCServer) drainTask(task *Task) {
+	task.mtx.Lock()
+	_, quorum := ra.selectDigest(task)
+	task.mtx.Unlock()
+
+	if quorum {
+		ra.finalizeTask(task.taskID)
+		return
+	}
+	ra.abortTask(task)
+}
+
+// abortTask releases the operators waiting on a task with a shutting down
+// error. Unlike a finalized task, no result is stored, and the task is
+// deleted from the store, so that the operators submitting again once the
+// aggregator is back start a new task rather than get a failed result.
+func (ra *AggregatorRPCServer) abortTask(task *Task) {
+	task.mtx.Lock()
+	defer task.mtx.Unlock()
+
+	if task.finalized {
+		return
+	}
+	task.finalized = true
+	task.timer.Stop()
+
+	ra.metrics.TasksFailed.With("reason", failureReasonShuttingDown).Add(1)
+	err := ra.store.DeleteTask(task.taskID)
+	if errors.Is(err, aggstore.ErrStoreClosed) {
+		ra.logger.Info("Aborted task not deleted after stop", "taskID", task.taskID)
+	} else if err != nil {
+		ra.logger.Error("Failed to delete aborted task", "taskID", task.taskID, "error", err)
+	}
+
+	ra.tasksMutex.Lock()
+	delete(ra.tasks, task.taskID)
+	delete(ra.tasksLocks, task.taskID)
+	ra.releaseTaskLocked(task.request.ChainId)
+	ra.tasksMutex.Unlock()
+
+	task.result = ra.createErrorValidatedResponse(task.taskID, &rpctypes.RPCError{
+		Code:    errcode.ShuttingDown,
+		Message: "Aggregator is shutting down, submit the response again later",
+		Data:    task.taskID,
+	})
+	close(task.done)
+
+	ra.logger.Info("Task aborted on shutdown", "taskID", task.taskID, "responses", len(task.operatorResponses))
+}
+
+// stopGRPCServer lets the gRPC server send the answers of the drained tasks
+// until the deadline, then closes the connections left open
+func (ra *AggregatorRPCServer) stopGRPCServer(deadline time.Time) {
+	stopped := make(chan struct{})
+	go func() {
+		ra.grpcServer.GracefulStop()
+		close(stopped)
+	}()
+
+	timer := time.NewTimer(time.Until(deadline))
+	defer timer.Stop()
+	select {
+	case <-stopped:
+	case <-timer.C:
+		ra.grpcServer.Stop()
+	}
+}
+
+// ListTasks reports the in-flight aggregation tasks, the earliest deadline first
+func (ra *AggregatorRPCServer) ListTasks(_ struct{}, reply *[]aggtypes.TaskInfo) error {
+	ra.tasksMutex.RLock()
+	tasks := make([]*Task, 0, len(ra.tasks))
+	for _, task := range ra.tasks {
+		tasks = append(tasks, task)
+	}
+	ra.tasksMutex.RUnlock()
+
+	infos := make([]aggtypes.TaskInfo, 0, len(tasks))
+	for _, task := range tasks {
+		infos = append(infos, ra.taskInfo(task))
+	}
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].Deadline.Before(infos[j].Deadline)
+	})
+
+	*reply = infos
+	return nil
+}
+
+// GetTaskResult returns the result of the finalized task of the request with
+// the given hash, as long as it is cached or kept in the task store
+func (ra *AggregatorRPCServer) GetTaskResult(requestHash []byte, reply *aggtypes.ValidatedResponse) error {
+	taskID := hex.EncodeToString(requestHash)
+
+	ra.tasksMutex.RLock()
+	cached := ra.results[taskID]
+	ra.tasksMutex.RUnlock()
+	if cached != nil {
+		*reply = *cached.result
+		return nil
+	}
+
+	result, err := ra.store.LoadResult(taskID)
+	if err != nil {
+		return fmt.Errorf("failed to load task result: %v", err)
+	}
+	if result == nil {
+		return aggtypes.ErrTaskResultNotFound
+	}
+	*reply = *result
+	return nil
+}
+
+// ListEvidence returns the recorded evidence of operators that signed
+// conflicting digests for a request, filtered by the query
+func (ra *AggregatorRPCServer) ListEvidence(query aggtypes.EvidenceQuery, reply *[]aggtypes.Evidence) error {
+	evidence, err := ra.store.ListEvidence(query)
+	if err != nil {
+		return fmt.Errorf("failed to load evidence: %v", err)
+	}
+	*reply = evidence
+	return nil
+}
+
+// taskInfo takes a snapshot of the task for inspection
+func (ra *AggregatorRPCServer) taskInfo(task *Task) aggtypes.TaskInfo {
+	task.mtx.Lock()
+	defer task.mtx.Unlock()
+
+	info := aggtypes.TaskInfo{
+		RequestHash:          task.request.Hash(),
+		Request:              task.request,
+		ChainID:              task.request.ChainId,
+		BlockNumber:          task.blockNumber,
+		GroupNumbers:         task.request.GroupNumbers,
+		ThresholdPercentages: task.request.GroupThresholdPercentages,
+		Deadline:             task.deadline,
+		TotalStakePerGroup:   copyStakePerGroup(task.totalStakePerGroup),
+		Signers:              make([][32]byte, 0, len(task.operatorResponses)),
+		Digests:              make([]aggtypes.DigestInfo, 0, len(task.digestToOperators)),
+		MissingOperators:     make([][32]byte, 0),
+	}
+
+	for operatorID := range task.operatorResponses {
+		info.Signers = append(info.Signers, operatorID)
+	}
+	sortOperatorIDs(info.Signers)
+
+	for digest, operatorIDs := range task.digestToOperators {
+		signers := make([][32]byte, 0, len(operatorIDs))
+		for _, operatorID := range operatorIDs {
+			signers = append(signers, operatorID)
+		}
+		sortOperatorIDs(signers)
+		info.Digests = append(info.Digests, aggtypes.DigestInfo{
+			Digest:        digest,
+			Signers:       signers,
+			StakePerGroup: copyStakePerGroup(task.signedStakePerDigest[digest]),
+		})
+	}
+	sort.Slice(info.Digests, func(i, j int) bool {
+		return bytes.Compare(info.Digests[i].Digest[:], info.Digests[j].Digest[:]) < 0
+	})
+
+	for operatorID := range task.operatorsDvsStateDict {
+		if _, signed := task.operatorResponses[operatorID]; !signed {
+			info.MissingOperators = append(info.MissingOperators, operatorID)
+		}
+	}
+	sortOperatorIDs(info.MissingOperators)
+
+	return info
+}
+
+func copyStakePerGroup(stakes map[types.GroupNumber]*big.Int) map[uint32]*big.Int {
+	copied := make(map[uint32]*big.Int, len(stakes))
+	for groupNumber, stake := range stakes {
+		copied[uint32(groupNumber)] = new(big.Int).Set(stake)
+	}
+	return copied
+}
+
+func sortOperatorIDs(operatorIDs [][32]byte) {
+	sort.Slice(operatorIDs, func(i, j int) bool {
+		return bytes.Compare(operatorIDs[i][:], operatorIDs[j][:]) < 0
+	})
+}
+
+// startPrometheusServer starts a Prometheus HTTP server serving the
+// aggregator metrics on its own listener
+func (ra *AggregatorRPCServer) startPrometheusServer() *http.Server {
+	srv := &http.Server{
+		Addr: ra.prometheusAddr,
+		Handler: promhttp.InstrumentMetricHandler(
+			prometheus.DefaultRegisterer, promhttp.HandlerFor(
+				prometheus.DefaultGatherer,
+				promhttp.HandlerOpts{},
+			),
+		),
+		ReadHeaderTimeout: readHeaderTimeout,
+	}
+	go func() {
+		if err := srv.ListenAndServe(); err != http.ErrServerClosed {
+			// Error starting or closing listener:
+			ra.logger.Error("Prometheus HTTP server ListenAndServe", "err", err)
+		}
+	}()
+	ra.logger.Info("Prometheus server started", "address", ra.prometheusAddr)
+	return srv
+}
+
+// GetTaskStatus reports the state of the aggregation task of the request
+// with the given hash
+func (ra *AggregatorRPCServer) GetTaskStatus(requestHash []byte, reply *aggtypes.TaskStatus) error {
+	taskID := hex.EncodeToString(requestHash)
+
+	ra.tasksMutex.RLock()
+	task, exists := ra.tasks[taskID]
+	cached := ra.results[taskID]
+	ra.tasksMutex.RUnlock()
+	if !exists && cached != nil {
+		*reply = aggtypes.TaskStatus{State: aggtypes.TaskStateFinalized, Result: cached.result}
+		return nil
+	}
+	if !exists {
+		result, err := ra.store.LoadResult(taskID)
+		if err != nil {
+			return fmt.Errorf("failed to load task result: %v", err)
+		}
+		if result == nil {
+			*reply = aggtypes.TaskStatus{State: aggtypes.TaskStateUnknown}
+			return nil
+		}
+		*reply = aggtypes.TaskStatus{State: aggtypes.TaskStateFinalized, Result: result}
+		return nil
+	}
+
+	task.mtx.Lock()
+	defer task.mtx.Unlock()
+
+	*reply = aggtypes.TaskStatus{
+		State:          aggtypes.TaskStatePending,
+		ResponsesCount: len(task.operatorResponses),
+	}
+	if task.finalized {
+		reply.State = aggtypes.TaskStateFinalized
+		reply.Result = task.result
+	}
+	return nil
 }
 
 // CollectResponseSignature processes operator signature submissions
 // creating or updating tasks and managing the aggregation process
 func (ra *AggregatorRPCServer) CollectResponseSignature(response *aggtypes.ResponseWithSignature,
 	result *aggtypes.ValidatedResponse) error {
-	taskID := ra.generateTaskID(response.RequestData)
+	sub := &submission{taskID: ra.generateTaskID(response.RequestData), response: response}
 	ra.logger.Info("CollectResponseSignature start",
-		"taskID", taskID, "operatorID", response.OperatorID,
+		"taskID", sub.taskID, "operatorID", response.OperatorID,
 		"response", response,
 		"result", result,
 	)
 
+	if err := ra.admitSubmission(sub); err != nil {
+		return err
+	}
+	if sub.result == nil {
+		if err := ra.verifyResponseSignature(sub.task, response); err != nil {
+			ra.rejectInvalidSignature(sub, err)
+		} else {
+			ra.submitResponse(sub)
+		}
+	}
+	ra.awaitResult(sub)
+	*result = *sub.result
+
+	ra.logger.Info("CollectResponseSignature done",
+		"taskID", sub.taskID,
+		"operatorID", response.OperatorID,
+		"result", result,
+	)
+
+	return nil
+}
+
+// submission follows an operator response through the aggregator until it
+// is answered, either right away when it is rejected or its task is already
+// finalized, or with the result of the task it joins
+type submission struct {
+	taskID   string
+	response *aggtypes.ResponseWithSignature
+	task     *Task
+	result   *aggtypes.ValidatedResponse
+}
+
+// admitSubmission authenticates the operator of the submission and finds
+// the task it joins. Rejected submissions and the ones whose task is already
+// finalized are answered right away. Errors other than rejections are returned.
+func (ra *AggregatorRPCServer) admitSubmission(sub *submission) error {
+	response := sub.response
+	ra.metrics.SignaturesReceived.Add(1)
+
+	// authenticate the operator before the submission can make us read the
+	// chain or hold a task open
+	if !ra.allowUnauthenticated {
+		if err := response.VerifyOperator(); err != nil {
+			ra.logger.Error("Rejected unauthenticated operator response",
+				"taskID", sub.taskID, "operatorID", response.OperatorID,
+				"error", err,
+			)
+			ra.metrics.SubmissionsUnauthenticated.Add(1)
+			sub.result = ra.createErrorValidatedResponse(sub.taskID, &rpctypes.RPCError{
+				Code:    errcode.Unauthenticated,
+				Message: fmt.Sprintf("Unauthenticated operator submission: %v", err),
+				Data:    sub.taskID,
+			})
+			return nil
+		}
+	}
+
+	task, cached, err := ra.admitResponse(sub.taskID, response)
+	if err != nil {
+		var rejection *admissionError
+		if !errors.As(err, &rejection) {
+			return err
+		}
+		ra.rejectSubmission(sub, rejection)
+		return nil
+	}
+	if cached != nil {
+		ra.logger.Info("Task already finalized, returning cached result",
+			"taskID", sub.taskID, "operatorID", response.OperatorID)
+		ra.metrics.SignaturesLate.Add(1)
+		if cached.task != nil {
+			ra.checkLateEquivocation(cached.task, response)
+		}
+		result := ra.lateResult(cached, response.OperatorID)
+		sub.result = &result
+		return nil
+	}
+
+	sub.task = task
+	return nil
+}
+
+// rejectSubmission answers the submission with the errcode of its rejection
+func (ra *AggregatorRPCServer) rejectSubmission(sub *submission, rejection *admissionError) {
+	ra.logger.Error("Rejected operator response",
+		"taskID", sub.taskID, "operatorID", sub.response.OperatorID,
+		"error", rejection,
+	)
+	ra.metrics.SubmissionsRejected.With("reason", rejection.reason).Add(1)
+	sub.result = ra.createErrorValidatedResponse(sub.taskID, &rpctypes.RPCError{
+		Code:    rejection.code,
+		Message: fmt.Sprintf("Operator response rejected: %v", rejection),
+		Data:    sub.taskID,
+	})
+}
+
+// rejectInvalidSignature answers a submission whose BLS signature does not verify
+func (ra *AggregatorRPCServer) rejectInvalidSignature(sub *submission, err error) {
+	ra.logger.Error("Rejected operator response with invalid signature",
+		"taskID", sub.taskID, "operatorID", sub.response.OperatorID,
+		"error", err,
+	)
+	ra.metrics.SignaturesInvalid.Add(1)
+	sub.result = ra.createErrorValidatedResponse(sub.taskID, &rpctypes.RPCError{
+		Code:    errcode.InvalidSignature,
+		Message: fmt.Sprintf("Invalid operator signature: %v", err),
+		Data:    sub.taskID,
+	})
+}
+
+// submitResponse adds the verified response of the submission to its task,
+// finalizing the task if the response brings it to quorum
+func (ra *AggregatorRPCServer) submitResponse(sub *submission) {
+	ra.metrics.SignaturesValid.Add(1)
+
+	ra.logger.Info("Adding response to the task",
+		"taskID", sub.taskID, "operatorID", sub.response.OperatorID)
+	if ra.addResponse(sub.task, *sub.response) {
+		ra.finalizeTask(sub.taskID)
+	}
+}
+
+// awaitResult waits for the task of a submission not answered yet and
+// answers it with the task result
+func (ra *AggregatorRPCServer) awaitResult(sub *submission) {
+	if sub.result != nil {
+		return
+	}
+
+	ra.logger.Info("Waiting for task result",
+		"taskID", sub.taskID, "operatorID", sub.response.OperatorID)
+	<-sub.task.done
+
+	result := *sub.task.result
+	if result.Err == nil {
+		// the response may have been left out of the aggregate
+		result.NotIncluded = !sub.task.signers[sub.response.OperatorID]
+	}
+	sub.result = &result
+}
+
+// admitResponse applies admission control to an operator response and
+// returns the task it joins, or the cached result of that task if it has
+// already been finalized. Rejections are returned as *admissionError.
+func (ra *AggregatorRPCServer) admitResponse(taskID string,
+	response *aggtypes.ResponseWithSignature) (*Task, *finalizedResult, error) {
+	if err := ra.checkRequest(response.RequestData); err != nil {
+		return nil, nil, err
+	}
+
+	task, cached, err := ra.getOrCreateTask(taskID, response.RequestData, response.OperatorID)
+	if err != nil || cached != nil {
+		return nil, cached, err
+	}
+	if err := checkMembership(task, response.OperatorID); err != nil {
+		return nil, nil, err
+	}
+	return task, nil, nil
+}
+
+// getOrCreateTask returns the in-flight task for the request, reading the
+// operator and group state at the request height when the task is new.
+// Only the first submission for a request pays for the chain reads, the
+// others wait on the per-task lock and reuse the created task. If the task
+// has already been finalized, its cached or persisted result is returned
+// instead.
+// A new task is only created if the limits on concurrent tasks allow it and
+// the submitting operator is a member of one of the requested groups.
+func (ra *AggregatorRPCServer) getOrCreateTask(taskID string,
+	request avsitypes.DVSRequest, operatorID types.OperatorID) (*Task, *finalizedResult, error) {
 	ra.tasksMutex.Lock()
+	if cached, exists := ra.results[taskID]; exists {
+		ra.tasksMutex.Unlock()
+		return nil, cached, nil
+	}
 	taskLock, exists := ra.tasksLocks[taskID]
 	if !exists {
 		taskLock = &sync.Mutex{}
@@ -116,145 +736,508 @@ func (ra *AggregatorRPCServer) CollectResponseSignature(response *aggtypes.Respo
 	taskLock.Lock()
 	defer taskLock.Unlock()
 
+	ra.tasksMutex.RLock()
 	task, exists := ra.tasks[taskID]
-	if !exists {
-		chainID := big.NewInt(response.RequestData.ChainId)
-		chainConfig, ok := ra.chainConfigs[chainID.Uint64()]
-		if !ok {
-			return fmt.Errorf("chain config not found for chain ID: %s", chainID.String())
-		}
+	cached := ra.results[taskID]
+	ra.tasksMutex.RUnlock()
+	if exists {
+		ra.logger.Info("Task already exists", "taskID", taskID)
+		return task, nil, nil
+	}
+	if cached != nil {
+		return nil, cached, nil
+	}
+	if cached, err := ra.loadResult(taskID); err != nil || cached != nil {
+		return nil, cached, err
+	}
 
-		groupNumbers := types.GroupNumbers{}
-		for _, groupNumber := range response.RequestData.GroupNumbers {
-			groupNumbers = append(groupNumbers, types.GroupNumber(groupNumber))
-		}
+	if err := ra.reserveTask(request.ChainId); err != nil {
+		return nil, nil, err
+	}
 
-		thresholdPercentages := types.GroupThresholdPercentages{}
-		for _, thresholdPercentage := range response.RequestData.GroupThresholdPercentages {
-			thresholdPercentages = append(thresholdPercentages, types.GroupThresholdPercentage(thresholdPercentage))
-		}
+	task, err := ra.newTask(taskID, request)
+	if err != nil {
+		ra.releaseTask(request.ChainId)
+		ra.metrics.TasksFailed.With("reason", failureReasonCreateTask).Add(1)
+		return nil, nil, err
+	}
+	if err := checkMembership(task, operatorID); err != nil {
+		ra.releaseTask(request.ChainId)
+		return nil, nil, err
+	}
 
-		blockNumber := uint32(response.RequestData.Height)
+	quorumReached, err := ra.replayPendingRestore(task)
+	if err != nil {
+		ra.releaseTask(request.ChainId)
+		return nil, nil, err
+	}
 
-		operatorsDvsStateDict, err := ra.dvsReader.GetOperatorsDVSStateAtBlock(chainID.Uint64(), groupNumbers, blockNumber)
-		if err != nil {
-			ra.logger.Error("Failed to get operators DVS state", "block", blockNumber, "error", err)
-			return err
-		}
+	deadline := time.Now().Add(ra.operatorResponseTimeout)
+	if err := ra.store.SaveTask(taskID, request, deadline); err != nil {
+		ra.releaseTask(request.ChainId)
+		return nil, nil, fmt.Errorf("failed to persist task: %v", err)
+	}
 
-		groupsDvsStateDict, err := ra.dvsReader.GetGroupsDVSStateAtBlock(chainID.Uint64(), groupNumbers, blockNumber)
-		if err != nil {
-			ra.logger.Error("Failed to get groups DVS state", "block", blockNumber, "error", err)
-			return err
-		}
+	ra.startTask(task, deadline)
+	ra.metrics.TasksCreated.Add(1)
+	if quorumReached {
+		ra.finalizeTask(taskID)
+	}
+	return task, nil, nil
+}
 
-		operatorStateInfo, err := ra.dvsReader.GetOperatorState(chainID.Uint64(), groupNumbers, blockNumber)
-		if err != nil {
-			ra.logger.Error("Failed to get operator state", "error", err)
-			return fmt.Errorf("failed to get operator state: %v", err)
-		}
-
-		task = &Task{
-			operatorResponses:     make(map[types.OperatorID]aggtypes.ResponseWithSignature),
-			responsesChan:         make(chan aggtypes.ResponseWithSignature, len(operatorStateInfo.Operators)),
-			done:                  make(chan aggtypes.ValidatedResponse, len(operatorStateInfo.Operators)),
-			taskID:                taskID,
-			chainConfig:           chainConfig,
-			digestToOperators:     make(map[ResultDigest][]types.OperatorID),
-			operatorStateInfo:     operatorStateInfo,
-			groupOperatorMap:      groupsDvsStateDict,
-			operatorsDvsStateDict: operatorsDvsStateDict,
-			groupNumbers:          groupNumbers,
-			thresholdPercentages:  thresholdPercentages,
-			blockNumber:           blockNumber,
-		}
-		ra.tasks[taskID] = task
-
-		ra.logger.Info("New task created",
-			"taskID", taskID,
-			"chainID", chainID,
-			"blockNumber", blockNumber,
-			"groupNumbers", groupNumbers,
-			"thresholdPercentages", thresholdPercentages,
-			"operatorsCount", len(operatorStateInfo.Operators),
-			"operatorsDvsStateDict", operatorsDvsStateDict,
-			"groupsDvsStateDict", groupsDvsStateDict,
-			"operatorStateInfo", operatorStateInfo,
-		)
-		ra.logger.Info("Task created and we will finalize it after timeout",
-			"taskID", taskID,
-			"operatorResponseTimeout", ra.operatorResponseTimeout,
-		)
-		task.timer = time.AfterFunc(ra.operatorResponseTimeout, func() {
-			ra.logger.Info("Timer triggered, calling finalizeTask", "taskID", taskID, "timeout", ra.operatorResponseTimeout)
-			ra.finalizeTask(taskID)
-		})
-		go ra.processResponses(task)
-	} else {
-		ra.logger.Info("Task already exists", "taskID", taskID)
+// replayPendingRestore adds the stored responses of a task that could not be
+// restored on start to the task created for it again, and reports whether
+// they bring the task to quorum
+func (ra *AggregatorRPCServer) replayPendingRestore(task *Task) (bool, error) {
+	ra.tasksMutex.Lock()
+	_, pending := ra.pendingRestores[task.taskID]
+	delete(ra.pendingRestores, task.taskID)
+	ra.tasksMutex.Unlock()
+	if !pending {
+		return false, nil
 	}
 
-	ra.logger.Info("Adding response to the shared channel",
-		"taskID", taskID, "operatorID", response.OperatorID)
-	task.responsesChan <- *response
+	responses, err := ra.store.LoadResponses(task.taskID)
+	if err != nil {
+		return false, fmt.Errorf("failed to load stored responses: %v", err)
+	}
+	for _, response := range responses {
+		ra.recordResponse(task, *response)
+	}
+	ra.logger.Info("Task pending restore created again", "taskID", task.taskID, "responses", len(responses))
+	return ra.checkQuorum(task), nil
+}
 
-	ra.logger.Info("Waiting for task result",
-		"taskID", taskID, "operatorID", response.OperatorID)
-	validatedResponse := <-task.done
+// newTask reads the operator and group state at the request height and
+// builds the task collecting the operator responses for the request
+func (ra *AggregatorRPCServer) newTask(taskID string, request avsitypes.DVSRequest) (*Task, error) {
+	chainID := big.NewInt(request.ChainId)
+	chainConfig, ok := ra.chainConfigs[chainID.Uint64()]
+	if !ok {
+		return nil, fmt.Errorf("%w: chain config not found for chain ID: %s", errInvalidTask, chainID.String())
+	}
+
+	groupNumbers := types.GroupNumbers{}
+	for _, groupNumber := range request.GroupNumbers {
+		groupNumbers = append(groupNumbers, types.GroupNumber(groupNumber))
+	}
 
-	*result = validatedResponse
+	thresholdPercentages := types.GroupThresholdPercentages{}
+	for _, thresholdPercentage := range request.GroupThresholdPercentages {
+		thresholdPercentages = append(thresholdPercentages, types.GroupThresholdPercentage(thresholdPercentage))
+	}
 
-	ra.logger.Info("CollectResponseSignature done",
+	if len(groupNumbers) != len(thresholdPercentages) {
+		return nil, fmt.Errorf("%w: group numbers count %d does not match threshold percentages count %d",
+			errInvalidTask, len(groupNumbers), len(thresholdPercentages))
+	}
+
+	thresholdPercentagesMap := make(map[types.GroupNumber]types.GroupThresholdPercentage)
+	for i, groupNumber := range groupNumbers {
+		thresholdPercentagesMap[groupNumber] = thresholdPercentages[i]
+	}
+
+	blockNumber := uint32(request.Height)
+
+	snapshot, err := ra.operatorSnapshot(chainID.Uint64(), groupNumbers, blockNumber)
+	if err != nil {
+		return nil, err
+	}
+	operatorsDvsStateDict := snapshot.operatorsDvsStateDict
+	groupsDvsStateDict := snapshot.groupsDvsStateDict
+	operatorStateInfo := snapshot.operatorStateInfo
+
+	totalStakePerGroup := make(map[types.GroupNumber]*big.Int)
+	for groupNum, groupDvsState := range groupsDvsStateDict {
+		totalStakePerGroup[groupNum] = groupDvsState.TotalStake
+	}
+
+	task := &Task{
+		request:               request,
+		createdAt:             time.Now(),
+		operatorResponses:     make(map[types.OperatorID]aggtypes.ResponseWithSignature),
+		done:                  make(chan struct{}),
+		taskID:                taskID,
+		chainConfig:           chainConfig,
+		digestToOperators:     make(map[ResultDigest][]types.OperatorID),
+		snapshot:              snapshot,
+		operatorStateInfo:     operatorStateInfo,
+		groupOperatorMap:      groupsDvsStateDict,
+		operatorsDvsStateDict: operatorsDvsStateDict,
+		groupNumbers:          groupNumbers,
+		thresholdPercentages:  thresholdPercentages,
+		blockNumber:           blockNumber,
+
+		thresholdPercentagesMap: thresholdPercentagesMap,
+		totalStakePerGroup:      totalStakePerGroup,
+		signedStakePerDigest:    make(map[ResultDigest]map[types.GroupNumber]*big.Int),
+	}
+
+	ra.logger.Info("New task created",
 		"taskID", taskID,
-		"operatorID", response.OperatorID,
-		"result", result,
+		"chainID", chainID,
+		"blockNumber", blockNumber,
+		"groupNumbers", groupNumbers,
+		"thresholdPercentages", thresholdPercentages,
+		"operatorsCount", len(operatorStateInfo.Operators),
+		"operatorsDvsStateDict", operatorsDvsStateDict,
+		"groupsDvsStateDict", groupsDvsStateDict,
+		"operatorStateInfo", operatorStateInfo,
+	)
+
+	return task, nil
+}
+
+// startTask schedules the finalization of the task at its deadline and
+// makes it visible to incoming operator responses
+func (ra *AggregatorRPCServer) startTask(task *Task, deadline time.Time) {
+	taskID := task.taskID
+	timeout := time.Until(deadline)
+	ra.logger.Info("Task created and we will finalize it after timeout",
+		"taskID", taskID,
+		"timeout", timeout,
 	)
+	task.deadline = deadline
+	task.timer = time.AfterFunc(timeout, func() {
+		ra.logger.Info("Timer triggered, calling finalizeTask", "taskID", taskID, "timeout", timeout)
+		ra.finalizeTask(taskID)
+	})
+
+	ra.tasksMutex.Lock()
+	ra.tasks[taskID] = task
+	ra.tasksMutex.Unlock()
+}
+
+// restoreTasks recreates the tasks that were in flight when the aggregator
+// stopped and replays the operator responses they had collected. Tasks whose
+// deadline passed while the aggregator was down are finalized right away.
+// Tasks that cannot be restored for now, e.g. because the chain cannot be
+// read, are kept in the store and tried again in the background.
+func (ra *AggregatorRPCServer) restoreTasks() error {
+	storedTasks, err := ra.store.LoadTasks()
+	if err != nil {
+		return err
+	}
+
+	for _, stored := range storedTasks {
+		err := ra.restoreTask(stored)
+		switch {
+		case err == nil:
+		case errors.Is(err, errInvalidTask):
+			ra.dropStoredTask(stored, err)
+		default:
+			ra.logger.Error("Failed to restore task, trying again later", "taskID", stored.TaskID, "error", err)
+			ra.tasksMutex.Lock()
+			ra.pendingRestores[stored.TaskID] = struct{}{}
+			ra.tasksMutex.Unlock()
+			go ra.retryRestoreTask(stored)
+		}
+	}
+
+	return nil
+}
+
+// restoreTask recreates a stored task and replays its responses, unless the
+// task was created again in the meantime
+func (ra *AggregatorRPCServer) restoreTask(stored *aggstore.StoredTask) error {
+	ra.tasksMutex.Lock()
+	taskLock, exists := ra.tasksLocks[stored.TaskID]
+	if !exists {
+		taskLock = &sync.Mutex{}
+		ra.tasksLocks[stored.TaskID] = taskLock
+	}
+	ra.tasksMutex.Unlock()
+
+	taskLock.Lock()
+	defer taskLock.Unlock()
+
+	ra.tasksMutex.RLock()
+	_, exists = ra.tasks[stored.TaskID]
+	_, cached := ra.results[stored.TaskID]
+	ra.tasksMutex.RUnlock()
+	if exists || cached {
+		return nil
+	}
+
+	task, err := ra.newTask(stored.TaskID, stored.Request)
+	if err != nil {
+		return err
+	}
 
+	task.createdAt = stored.Deadline.Add(-ra.operatorResponseTimeout)
+	for _, response := range stored.Responses {
+		ra.recordResponse(task, *response)
+	}
+	quorumReached := ra.checkQuorum(task)
+
+	ra.logger.Info("Task restored",
+		"taskID", stored.TaskID,
+		"responses", len(stored.Responses),
+		"deadline", stored.Deadline,
+	)
+	// restored tasks are not subject to the limits, they were admitted before
+	ra.tasksMutex.Lock()
+	delete(ra.pendingRestores, stored.TaskID)
+	ra.countTaskLocked(stored.Request.ChainId)
+	ra.tasksMutex.Unlock()
+	ra.startTask(task, stored.Deadline)
+	if quorumReached {
+		ra.finalizeTask(task.taskID)
+	}
 	return nil
 }
 
+// retryRestoreTask tries to restore a stored task again with a growing
+// backoff, until it is restored, created again by an operator submission,
+// found invalid, or the aggregator stops
+func (ra *AggregatorRPCServer) retryRestoreTask(stored *aggstore.StoredTask) {
+	backoff := restoreRetryBackoff
+	for {
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ra.Quit():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		ra.tasksMutex.RLock()
+		_, pending := ra.pendingRestores[stored.TaskID]
+		ra.tasksMutex.RUnlock()
+		if !pending {
+			return
+		}
+
+		err := ra.restoreTask(stored)
+		switch {
+		case err == nil:
+			return
+		case errors.Is(err, errInvalidTask):
+			ra.tasksMutex.Lock()
+			delete(ra.pendingRestores, stored.TaskID)
+			ra.tasksMutex.Unlock()
+			ra.dropStoredTask(stored, err)
+			return
+		}
+		ra.logger.Error("Failed to restore task, trying again later",
+			"taskID", stored.TaskID, "error", err, "backoff", backoff)
+		backoff = min(2*backoff, restoreRetryMaxBackoff)
+	}
+}
+
+// dropStoredTask deletes a stored task that can never be restored
+func (ra *AggregatorRPCServer) dropStoredTask(stored *aggstore.StoredTask, err error) {
+	ra.logger.Error("Failed to restore task, dropping it", "taskID", stored.TaskID, "error", err)
+	if err := ra.store.DeleteTask(stored.TaskID); err != nil {
+		ra.logger.Error("Failed to delete task", "taskID", stored.TaskID, "error", err)
+	}
+}
+
+// pruneResultsRoutine periodically deletes the finalized results that are
+// older than the result retention window
+func (ra *AggregatorRPCServer) pruneResultsRoutine() {
+	ticker := time.NewTicker(resultPruneInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ra.Quit():
+			return
+		case <-ticker.C:
+			pruned, err := ra.store.PruneResults(time.Now().Add(-ra.resultRetention))
+			if err != nil {
+				ra.logger.Error("Failed to prune task results", "error", err)
+				continue
+			}
+			if pruned > 0 {
+				ra.logger.Info("Pruned task results", "count", pruned, "retention", ra.resultRetention)
+			}
+		}
+	}
+}
+
 func (ra *AggregatorRPCServer) generateTaskID(request avsitypes.DVSRequest) string {
 	return hex.EncodeToString(request.Hash())
 }
 
-func (ra *AggregatorRPCServer) processResponses(task *Task) {
-	ra.logger.Info("processResponses started", "taskID", task.taskID)
-	for response := range task.responsesChan {
-		ra.logger.Info("processResponses. Processing response",
+// verifyResponseSignature checks the operator's BLS signature over the response
+// digest against the G2 public key registered for the operator at the task block
+func (ra *AggregatorRPCServer) verifyResponseSignature(task *Task, response *aggtypes.ResponseWithSignature) error {
+	pubkeyG2, err := signerPubkey(task, response)
+	if err != nil {
+		return err
+	}
+
+	valid, err := response.Signature.Verify(pubkeyG2, response.Digest)
+	if err != nil {
+		return fmt.Errorf("failed to verify signature: %v", err)
+	}
+	if !valid {
+		return fmt.Errorf("signature does not match digest %x", response.Digest)
+	}
+
+	return nil
+}
+
+// signerPubkey returns the G2 public key the signature of the response is
+// verified with, checking that the response can be verified at all
+func signerPubkey(task *Task, response *aggtypes.ResponseWithSignature) (*bls.G2Point, error) {
+	operatorState, ok := task.operatorsDvsStateDict[response.OperatorID]
+	if !ok {
+		return nil, fmt.Errorf("operator %x is not registered in groups %v at block %d",
+			response.OperatorID, task.groupNumbers, task.blockNumber)
+	}
+
+	pubkeyG2 := operatorState.OperatorInfo.Pubkeys.G2Pubkey
+	if pubkeyG2 == nil {
+		return nil, fmt.Errorf("operator %x has no G2 public key at block %d", response.OperatorID, task.blockNumber)
+	}
+
+	if response.Signature == nil || response.Signature.G1Point == nil || response.Signature.G1Affine == nil {
+		return nil, errors.New("signature is missing")
+	}
+	// signatures are decoded without checks, so points off the curve or at
+	// infinity are rejected before any pairing is computed with them
+	if response.Signature.G1Affine.IsInfinity() || !response.Signature.G1Affine.IsInSubGroup() {
+		return nil, errors.New("signature is not a point of the G1 subgroup")
+	}
+	return pubkeyG2, nil
+}
+
+// addResponse records a verified operator response in the task and reports
+// whether this response made one digest reach quorum, in which case the
+// caller is responsible for finalizing the task
+func (ra *AggregatorRPCServer) addResponse(task *Task, response aggtypes.ResponseWithSignature) bool {
+	task.mtx.Lock()
+	defer task.mtx.Unlock()
+
+	if task.finalized {
+		ra.logger.Info("Task already finalized, response not included",
 			"taskID", task.taskID, "operatorID", response.OperatorID)
+		ra.metrics.SignaturesLate.Add(1)
+		if previous, exists := task.operatorResponses[response.OperatorID]; exists && previous.Digest != response.Digest {
+			ra.recordEquivocation(task, previous, response)
+		}
+		return false
+	}
 
-		_, err := ra.dvsReader.GetOperatorInfoByID(response.OperatorID)
-		if err != nil {
-			ra.logger.Error("Failed to get operator info",
-				"taskID", task.taskID, "operatorID", response.OperatorID,
-				"error", err,
-			)
-			continue
+	if previous, exists := task.operatorResponses[response.OperatorID]; exists {
+		if previous.Digest != response.Digest {
+			ra.recordEquivocation(task, previous, response)
 		}
+		ra.logger.Info("Operator already responded, response ignored",
+			"taskID", task.taskID, "operatorID", response.OperatorID)
+		return false
+	}
+
+	if err := ra.store.SaveResponse(task.taskID, &response); err != nil {
+		ra.logger.Error("Failed to persist operator response",
+			"taskID", task.taskID, "operatorID", response.OperatorID, "error", err)
+	}
+
+	ra.recordResponse(task, response)
+	return ra.checkQuorum(task)
+}
+
+// recordEquivocation persists the evidence of an operator that signed two
+// different digests for the request of the task. Both responses have passed
+// signature verification before reaching the task.
+func (ra *AggregatorRPCServer) recordEquivocation(task *Task, first, second aggtypes.ResponseWithSignature) {
+	ra.logger.Error("Operator signed conflicting digests",
+		"taskID", task.taskID, "operatorID", first.OperatorID,
+		"firstDigest", first.Digest, "secondDigest", second.Digest,
+	)
 
-		task.operatorResponses[response.OperatorID] = response
-		task.digestToOperators[response.Digest] = append(task.digestToOperators[response.Digest], response.OperatorID)
-		time.Sleep(1 * time.Second)
+	saved, err := ra.store.SaveEvidence(&aggtypes.Evidence{
+		RequestHash: task.request.Hash(),
+		OperatorID:  first.OperatorID,
+		First:       first,
+		Second:      second,
+		DetectedAt:  time.Now(),
+	})
+	if err != nil {
+		ra.logger.Error("Failed to persist equivocation evidence",
+			"taskID", task.taskID, "operatorID", first.OperatorID, "error", err)
+		return
+	}
+	if saved {
+		ra.metrics.EquivocationsDetected.Add(1)
 	}
-	ra.logger.Info("processResponses.done", "taskID", task.taskID)
 }
 
+// checkLateEquivocation records the evidence of an operator whose submission
+// after the task was finalized conflicts with the response it gave before.
+// The late response is only taken as evidence once its signature verifies.
+func (ra *AggregatorRPCServer) checkLateEquivocation(task *Task, response *aggtypes.ResponseWithSignature) {
+	task.mtx.Lock()
+	defer task.mtx.Unlock()
+
+	previous, exists := task.operatorResponses[response.OperatorID]
+	if !exists || previous.Digest == response.Digest {
+		return
+	}
+	if err := ra.verifyResponseSignature(task, response); err != nil {
+		ra.logger.Error("Late conflicting response with invalid signature, no evidence recorded",
+			"taskID", task.taskID, "operatorID", response.OperatorID, "error", err)
+		return
+	}
+	ra.recordEquivocation(task, previous, *response)
+}
+
+// recordResponse adds the response and the stake of its operator to the task
+func (ra *AggregatorRPCServer) recordResponse(task *Task, response aggtypes.ResponseWithSignature) {
+	task.operatorResponses[response.OperatorID] = response
+	task.digestToOperators[response.Digest] = append(task.digestToOperators[response.Digest], response.OperatorID)
+	ra.addSignedStake(task, response.Digest, response.OperatorID)
+}
+
+// checkQuorum reports whether one digest of the task has just reached quorum.
+// It only reports it once so that a single caller finalizes the task.
+func (ra *AggregatorRPCServer) checkQuorum(task *Task) bool {
+	// Finalize as soon as one digest has enough stake in every group
+	// instead of waiting for the operator response timeout
+	if task.quorumReached {
+		return false
+	}
+	digest, ok := ra.selectDigest(task)
+	if !ok {
+		return false
+	}
+	task.quorumReached = true
+	ra.metrics.TimeToQuorumSeconds.Observe(time.Since(task.createdAt).Seconds())
+	ra.logger.Info("Quorum reached, finalizing task early",
+		"taskID", task.taskID, "digest", digest)
+	return true
+}
+
+// finalizeTask aggregates the collected signatures of the task and releases
+// every operator waiting on it. It is safe to call more than once, only the
+// first call aggregates.
 func (ra *AggregatorRPCServer) finalizeTask(taskID string) {
 	ra.logger.Info("finalizeTask started", "taskID", taskID)
-	ra.tasksMutex.Lock()
-	defer ra.tasksMutex.Unlock()
-
+	ra.tasksMutex.RLock()
 	task, exists := ra.tasks[taskID]
+	ra.tasksMutex.RUnlock()
 	if !exists {
 		return
 	}
 
+	task.mtx.Lock()
+	defer task.mtx.Unlock()
+
+	if task.finalized {
+		return
+	}
+	task.finalized = true
 	task.timer.Stop()
 
+	ra.metrics.TasksFinalized.Add(1)
 	aggregatedResult, err := ra.aggregateSignatures(task)
 	if err != nil {
 		// Log with more context including the task ID
 		ra.logger.Error("Failed to aggregate signatures", "taskID", taskID, "error", err)
+		ra.metrics.TasksFailed.With("reason", failureReason(err)).Add(1)
 
 		// Create a more detailed error response with the original error message
 		aggregatedResult = ra.createErrorValidatedResponse(taskID, &rpctypes.RPCError{
@@ -264,17 +1247,148 @@ func (ra *AggregatorRPCServer) finalizeTask(taskID string) {
 		})
 	}
 
-	for operatorID := range task.operatorResponses {
-		task.done <- *aggregatedResult
-		ra.logger.Info("Task finalizeTask task.done for", "taskID", taskID, "operatorID", operatorID)
+	// A task finalized once the store is closed on stop is kept in the store
+	// as it was, and is finalized again on the next start
+	err = ra.store.SaveResult(taskID, aggregatedResult, time.Now())
+	if errors.Is(err, aggstore.ErrStoreClosed) {
+		ra.logger.Info("Task result not persisted after stop", "taskID", taskID)
+	} else if err != nil {
+		ra.logger.Error("Failed to persist task result", "taskID", taskID, "error", err)
 	}
 
-	close(task.done)
-	close(task.responsesChan)
+	signers := make(map[types.OperatorID]bool)
+	if digest, ok := ra.selectDigest(task); ok {
+		for _, operatorID := range task.digestToOperators[digest] {
+			signers[operatorID] = true
+		}
+	}
 
+	// The task is replaced by its cached result in one step so that a late
+	// submission either joins the task or gets the result, never a new task
+	ra.tasksMutex.Lock()
 	delete(ra.tasks, taskID)
+	delete(ra.tasksLocks, taskID)
+	ra.releaseTaskLocked(task.request.ChainId)
+	ra.results[taskID] = &finalizedResult{
+		result:    aggregatedResult,
+		signers:   signers,
+		task:      task,
+		expiresAt: time.Now().Add(ra.resultCacheTTL),
+	}
+	ra.tasksMutex.Unlock()
+
+	task.result = aggregatedResult
+	task.signers = signers
+	close(task.done)
+
+	ra.logger.Info("Task deleted", "taskID", taskID, "responses", len(task.operatorResponses))
+}
+
+// loadResult returns the persisted result of a task finalized earlier,
+// whose cached result expired or was lost with a restart, and caches it
+// again. The signers of a loaded result are not known, so late submissions
+// are answered with the result marked as not including them.
+func (ra *AggregatorRPCServer) loadResult(taskID string) (*finalizedResult, error) {
+	result, err := ra.store.LoadResult(taskID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load task result: %v", err)
+	}
+	if result == nil {
+		return nil, nil
+	}
+
+	cached := &finalizedResult{
+		result:    result,
+		signers:   make(map[types.OperatorID]bool),
+		expiresAt: time.Now().Add(ra.resultCacheTTL),
+	}
+	ra.tasksMutex.Lock()
+	ra.results[taskID] = cached
+	ra.tasksMutex.Unlock()
+	return cached, nil
+}
+
+// lateResult returns the cached result of a finalized task for an operator
+// submitting after finalization, marked as not including the operator
+// unless its signature was part of the aggregate
+func (ra *AggregatorRPCServer) lateResult(cached *finalizedResult,
+	operatorID types.OperatorID) aggtypes.ValidatedResponse {
+	result := *cached.result
+	result.NotIncluded = !cached.signers[operatorID]
+	return result
+}
+
+// evictResultsRoutine periodically drops the cached results of finalized
+// tasks once their TTL has passed
+func (ra *AggregatorRPCServer) evictResultsRoutine() {
+	ticker := time.NewTicker(resultCacheEvictInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ra.Quit():
+			return
+		case <-ticker.C:
+			ra.evictResults(time.Now())
+		}
+	}
+}
+
+// evictResults drops the cached results that expired before now, along
+// with the per-task locks left behind by requests that failed to create a task
+func (ra *AggregatorRPCServer) evictResults(now time.Time) {
+	ra.tasksMutex.Lock()
+	defer ra.tasksMutex.Unlock()
+
+	for taskID, cached := range ra.results {
+		if now.After(cached.expiresAt) {
+			delete(ra.results, taskID)
+		}
+	}
+
+	for taskID, taskLock := range ra.tasksLocks {
+		if _, exists := ra.tasks[taskID]; exists {
+			continue
+		}
+		// A held lock belongs to a task being created right now
+		if taskLock.TryLock() {
+			delete(ra.tasksLocks, taskID)
+			taskLock.Unlock()
+		}
+	}
+}
 
-	ra.logger.Info("Task deleted", "taskID", taskID)
+// failureReason maps an aggregation error to the reason label of TasksFailed
+func failureReason(err error) string {
+	switch {
+	case errors.Is(err, errNoSignatures):
+		return failureReasonNoSignatures
+	case errors.Is(err, errThresholdNotMet):
+		return failureReasonThresholdNotMet
+	case errors.Is(err, errInvalidAggregate):
+		return failureReasonInvalidAggregate
+	default:
+		return failureReasonAggregation
+	}
+}
+
+// observeNonSigners records, for every group of the task, how many of its
+// operators did not sign the aggregated digest
+func (ra *AggregatorRPCServer) observeNonSigners(task *Task, digest ResultDigest) {
+	for groupNum, operators := range task.operatorStateInfo.GroupOperatorMap {
+		nonSigners := 0
+		for _, operator := range operators {
+			if response, signed := task.operatorResponses[operator.OperatorID]; !signed || response.Digest != digest {
+				nonSigners++
+			}
+		}
+		ra.metrics.NonSigners.With("group", strconv.Itoa(int(groupNum))).Observe(float64(nonSigners))
+	}
+}
+
+// observeReaderCall records the duration of a DVSReader call begun at start
+func (ra *AggregatorRPCServer) observeReaderCall(method string, start time.Time) {
+	ra.metrics.DVSReaderCallDurationSeconds.With("method", method).Observe(time.Since(start).Seconds())
 }
 
 func (ra *AggregatorRPCServer) createErrorValidatedResponse(taskID string,
@@ -296,44 +1410,56 @@ func (ra *AggregatorRPCServer) createErrorValidatedResponse(taskID string,
 
 func (ra *AggregatorRPCServer) aggregateSignatures(task *Task) (*aggtypes.ValidatedResponse, error) {
 	ra.logger.Info("aggregateSignatures.start", "taskID", task.taskID)
-	if len(task.operatorResponses) == 0 {
-		return nil, errors.New("no signatures to aggregate")
+	result, digest, err := ra.buildAggregate(task)
+	if err != nil {
+		return nil, err
 	}
 
-	totalStakePerGroup := make(map[types.GroupNumber]*big.Int)
-	for groupNum, groupDvsState := range task.groupOperatorMap {
-		totalStakePerGroup[groupNum] = groupDvsState.TotalStake
+	// Run the check of the on-chain signature checker before handing the
+	// result out. If it fails, drop the signers whose keys or signatures are
+	// at fault and aggregate the remaining ones once more.
+	verifyErr := verifyAggregate(task, result, digest)
+	if verifyErr == nil {
+		return result, nil
 	}
-	var selectedDigest ResultDigest
-	var selectedData []byte
+	ra.logger.Error("Aggregated signature failed verification, looking for faulty signers",
+		"taskID", task.taskID, "error", verifyErr)
 
-	thresholdPercentagesMap := make(map[types.GroupNumber]types.GroupThresholdPercentage)
-	for groupNum, thresholdPercentage := range task.thresholdPercentages {
-		thresholdPercentagesMap[types.GroupNumber(groupNum)] = thresholdPercentage
+	faulty := findFaultySigners(task, digest)
+	if len(faulty) == 0 {
+		return nil, fmt.Errorf("%w: %v", errInvalidAggregate, verifyErr)
+	}
+	for _, operatorID := range faulty {
+		ra.logger.Error("Excluding faulty signer from the aggregate",
+			"taskID", task.taskID, "operatorID", operatorID)
+		ra.removeResponse(task, operatorID)
 	}
+	ra.metrics.SignersExcluded.Add(float64(len(faulty)))
 
-	signersTotalStakePerGroup := make(map[types.GroupNumber]*big.Int)
+	result, digest, err = ra.buildAggregate(task)
+	if err != nil {
+		return nil, err
+	}
+	if err := verifyAggregate(task, result, digest); err != nil {
+		return nil, fmt.Errorf("%w: %v", errInvalidAggregate, err)
+	}
+	return result, nil
+}
 
-	for digest, operators := range task.digestToOperators {
-		for _, addrOperatorID := range operators {
-			for groupNumber, stake := range task.operatorsDvsStateDict[addrOperatorID].StakePerGroup {
-				if _, ok := signersTotalStakePerGroup[groupNumber]; !ok {
-					signersTotalStakePerGroup[groupNumber] = big.NewInt(0)
-				}
-				signersTotalStakePerGroup[groupNumber].Add(signersTotalStakePerGroup[groupNumber], stake)
-			}
-			if ra.checkIfStakeThresholdsMet(signersTotalStakePerGroup, totalStakePerGroup, thresholdPercentagesMap) {
-				selectedDigest = digest
-				selectedData = task.operatorResponses[operators[0]].Data
-				ra.logger.Debug("Selected digest for aggregation checkIfStakeThresholdsMet", "digest", selectedDigest)
-				break
-			} else {
-				ra.logger.Error("stake thresholds not met for digest", "digest", digest)
-				return nil, fmt.Errorf("stake thresholds not met for digest: %v", digest)
-			}
-		}
+// buildAggregate aggregates the signatures of the operators that signed the
+// digest meeting the stake thresholds and returns the result with that digest
+func (ra *AggregatorRPCServer) buildAggregate(task *Task) (*aggtypes.ValidatedResponse, ResultDigest, error) {
+	if len(task.operatorResponses) == 0 {
+		return nil, ResultDigest{}, errNoSignatures
 	}
 
+	selectedDigest, ok := ra.selectDigest(task)
+	if !ok {
+		ra.logger.Error("stake thresholds not met for any digest", "taskID", task.taskID)
+		return nil, ResultDigest{}, errThresholdNotMet
+	}
+	selectedData := task.operatorResponses[task.digestToOperators[selectedDigest][0]].Data
+
 	ra.logger.Debug("Selected digest for aggregation", "digest", selectedDigest)
 
 	aggregatedSignature := bls.NewZeroSignature()
@@ -348,7 +1474,7 @@ func (ra *AggregatorRPCServer) aggregateSignatures(task *Task) (*aggtypes.Valida
 	for _, response := range task.operatorResponses {
 		if response.Digest == selectedDigest {
 			addrOperatorID := response.OperatorID
-			operator, _ := ra.dvsReader.GetOperatorInfoByID(addrOperatorID)
+			operator, _ := ra.operatorInfo(task, addrOperatorID)
 			aggregatedSignature.Add(response.Signature)
 			signersApkG2.Add(operator.Pubkeys.G2Pubkey)
 		}
@@ -365,9 +1491,9 @@ func (ra *AggregatorRPCServer) aggregateSignatures(task *Task) (*aggtypes.Valida
 		}
 		for _, operator := range operatorInfos {
 			addrOperatorID := operator.OperatorID
-			operatorInfo, err := ra.dvsReader.GetOperatorInfoByID(addrOperatorID)
+			operatorInfo, err := ra.operatorInfo(task, addrOperatorID)
 			if err != nil {
-				return nil, fmt.Errorf("failed to get operator info by ID: %v", err)
+				return nil, ResultDigest{}, fmt.Errorf("failed to get operator info by ID: %v", err)
 			}
 			registeredOperators[addrOperatorID] = operatorInfo
 
@@ -400,6 +1526,8 @@ func (ra *AggregatorRPCServer) aggregateSignatures(task *Task) (*aggtypes.Valida
 		}
 	}
 
+	ra.observeNonSigners(task, selectedDigest)
+
 	sort.SliceStable(nonSignersOperatorIds, func(i, j int) bool {
 		iOprInt := new(big.Int).SetBytes(nonSignersOperatorIds[i][:])
 		jOprInt := new(big.Int).SetBytes(nonSignersOperatorIds[j][:])
@@ -416,14 +1544,16 @@ func (ra *AggregatorRPCServer) aggregateSignatures(task *Task) (*aggtypes.Valida
 		nonSignersPubkeysG1 = append(nonSignersPubkeysG1, operator.Pubkeys.G1Pubkey)
 	}
 
+	start := time.Now()
 	indices, err := ra.dvsReader.GetCheckSignaturesIndices(
 		task.chainConfig.ChainID,
 		task.blockNumber,
 		task.groupNumbers,
 		nonSignersOperatorIds,
 	)
+	ra.observeReaderCall("get_check_signatures_indices", start)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get check signatures indices: %v", err)
+		return nil, ResultDigest{}, fmt.Errorf("failed to get check signatures indices: %v", err)
 	}
 	ra.logger.Debug("aggregateSignatures.indices", "indices", indices)
 
@@ -454,7 +1584,76 @@ func (ra *AggregatorRPCServer) aggregateSignatures(task *Task) (*aggtypes.Valida
 
 	ra.logger.Info("aggregateSignatures.result", "result", result)
 
-	return result, nil
+	return result, selectedDigest, nil
+}
+
+// addSignedStake adds the operator's stake in every group to the stake
+// tallied for the digest it signed
+func (ra *AggregatorRPCServer) addSignedStake(task *Task, digest ResultDigest, operatorID types.OperatorID) {
+	signedStakePerGroup, ok := task.signedStakePerDigest[digest]
+	if !ok {
+		signedStakePerGroup = make(map[types.GroupNumber]*big.Int)
+		task.signedStakePerDigest[digest] = signedStakePerGroup
+	}
+
+	for groupNumber, stake := range task.operatorsDvsStateDict[operatorID].StakePerGroup {
+		if _, ok := signedStakePerGroup[groupNumber]; !ok {
+			signedStakePerGroup[groupNumber] = big.NewInt(0)
+		}
+		signedStakePerGroup[groupNumber].Add(signedStakePerGroup[groupNumber], stake)
+	}
+}
+
+// removeResponse drops the response of an operator from the task, taking
+// its stake off the digest it signed
+func (ra *AggregatorRPCServer) removeResponse(task *Task, operatorID types.OperatorID) {
+	response, ok := task.operatorResponses[operatorID]
+	if !ok {
+		return
+	}
+	delete(task.operatorResponses, operatorID)
+
+	digest := ResultDigest(response.Digest)
+	operators := task.digestToOperators[digest]
+	for i, id := range operators {
+		if id == operatorID {
+			task.digestToOperators[digest] = append(operators[:i:i], operators[i+1:]...)
+			break
+		}
+	}
+	if len(task.digestToOperators[digest]) == 0 {
+		delete(task.digestToOperators, digest)
+		delete(task.signedStakePerDigest, digest)
+		return
+	}
+
+	signedStakePerGroup := task.signedStakePerDigest[digest]
+	for groupNumber, stake := range task.operatorsDvsStateDict[operatorID].StakePerGroup {
+		if signed, ok := signedStakePerGroup[groupNumber]; ok {
+			signed.Sub(signed, stake)
+		}
+	}
+}
+
+// selectDigest returns the digest whose signers meet the stake threshold of
+// every requested group. Digests are checked in ascending byte order so the
+// selection does not depend on map iteration order.
+func (ra *AggregatorRPCServer) selectDigest(task *Task) (ResultDigest, bool) {
+	digests := make([]ResultDigest, 0, len(task.signedStakePerDigest))
+	for digest := range task.signedStakePerDigest {
+		digests = append(digests, digest)
+	}
+	sort.Slice(digests, func(i, j int) bool {
+		return bytes.Compare(digests[i][:], digests[j][:]) < 0
+	})
+
+	for _, digest := range digests {
+		if ra.checkIfStakeThresholdsMet(task.signedStakePerDigest[digest],
+			task.totalStakePerGroup, task.thresholdPercentagesMap) {
+			return digest, true
+		}
+	}
+	return ResultDigest{}, false
 }
 
 func (ra *AggregatorRPCServer) checkIfStakeThresholdsMet(
@@ -0,0 +1,1040 @@
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/rpc"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	interactorcfg "github.com/0xPellNetwork/pelldvs-interactor/config"
+	"github.com/0xPellNetwork/pelldvs-interactor/interactor/reader"
+	"github.com/0xPellNetwork/pelldvs-interactor/types"
+	"github.com/0xPellNetwork/pelldvs-libs/crypto/bls"
+	"github.com/0xPellNetwork/pelldvs-libs/log"
+	aggcfg "github.com/0xPellNetwork/pelldvs/aggregator/config"
+	aggstore "github.com/0xPellNetwork/pelldvs/aggregator/store"
+	aggtypes "github.com/0xPellNetwork/pelldvs/aggregator/types"
+	avsitypes "github.com/0xPellNetwork/pelldvs/avsi/types"
+	"github.com/0xPellNetwork/pelldvs/rpc/core/errcode"
+	pelltypes "github.com/0xPellNetwork/pelldvs/types"
+)
+
+const (
+	testChainID     = 1
+	testGroupNumber = types.GroupNumber(0)
+)
+
+// testOperator is an operator registered in the test group with its BLS
+// keys and the ECDSA key its ID is derived from. When set, registeredG1 is
+// the G1 public key registered for the operator instead of its own.
+type testOperator struct {
+	id           types.OperatorID
+	keyPair      *bls.KeyPair
+	ecdsaKey     *ecdsa.PrivateKey
+	registeredG1 *bls.G1Point
+}
+
+// testDVSReader serves a fixed operator set for every block. Methods the
+// aggregator does not call are left to the embedded nil interface.
+type testDVSReader struct {
+	reader.DVSReader
+	operators []testOperator
+
+	stateReads  atomic.Int64 // calls to GetOperatorState
+	infoReads   atomic.Int64 // calls to GetOperatorInfoByID
+	unavailable atomic.Bool  // when set, the operators cannot be read
+}
+
+func newTestDVSReader(t testing.TB, count int) *testDVSReader {
+	operators := make([]testOperator, 0, count)
+	for i := 0; i < count; i++ {
+		keyPair, err := bls.GenRandomBlsKeys()
+		require.NoError(t, err)
+		ecdsaKey, err := crypto.GenerateKey()
+		require.NoError(t, err)
+
+		id := types.OperatorID(pelltypes.OperatorIDFromAddress(crypto.PubkeyToAddress(ecdsaKey.PublicKey)))
+		operators = append(operators, testOperator{id: id, keyPair: keyPair, ecdsaKey: ecdsaKey})
+	}
+	return &testDVSReader{operators: operators}
+}
+
+func (r *testDVSReader) operatorInfo(operator testOperator) types.OperatorInfo {
+	info := types.OperatorInfo{}
+	info.Pubkeys.G1Pubkey = operator.keyPair.GetPubKeyG1()
+	if operator.registeredG1 != nil {
+		info.Pubkeys.G1Pubkey = operator.registeredG1
+	}
+	info.Pubkeys.G2Pubkey = operator.keyPair.GetPubKeyG2()
+	return info
+}
+
+func (r *testDVSReader) GetOperatorsDVSStateAtBlock(_ uint64, _ types.GroupNumbers,
+	_ uint32) (map[types.OperatorID]types.OperatorDVSState, error) {
+	if r.unavailable.Load() {
+		return nil, errors.New("chain unavailable")
+	}
+	states := make(map[types.OperatorID]types.OperatorDVSState, len(r.operators))
+	for _, operator := range r.operators {
+		state := types.OperatorDVSState{}
+		state.OperatorID = operator.id
+		state.OperatorInfo = r.operatorInfo(operator)
+		state.StakePerGroup = map[types.GroupNumber]*big.Int{testGroupNumber: big.NewInt(100)}
+		states[operator.id] = state
+	}
+	return states, nil
+}
+
+func (r *testDVSReader) GetGroupsDVSStateAtBlock(_ uint64, _ types.GroupNumbers,
+	_ uint32) (map[types.GroupNumber]types.GroupDVSState, error) {
+	apk := bls.NewZeroG1Point()
+	for _, operator := range r.operators {
+		apk.Add(r.operatorInfo(operator).Pubkeys.G1Pubkey)
+	}
+
+	state := types.GroupDVSState{}
+	state.TotalStake = big.NewInt(int64(100 * len(r.operators)))
+	state.AggPubkeyG1 = apk
+	return map[types.GroupNumber]types.GroupDVSState{testGroupNumber: state}, nil
+}
+
+func (r *testDVSReader) GetOperatorState(_ uint64, _ types.GroupNumbers,
+	_ uint32) (*reader.OperatorStateInfo, error) {
+	r.stateReads.Add(1)
+	info := &reader.OperatorStateInfo{
+		Operators:        make(map[types.OperatorID]common.Address, len(r.operators)),
+		GroupStakes:      map[types.GroupNumber]*big.Int{testGroupNumber: big.NewInt(int64(100 * len(r.operators)))},
+		GroupOperatorMap: make(map[types.GroupNumber][]reader.OperatorStakeInfo),
+	}
+	for _, operator := range r.operators {
+		address := crypto.PubkeyToAddress(operator.ecdsaKey.PublicKey)
+		info.Operators[operator.id] = address
+		info.GroupOperatorMap[testGroupNumber] = append(info.GroupOperatorMap[testGroupNumber],
+			reader.OperatorStakeInfo{Operator: address, OperatorID: operator.id, Stake: big.NewInt(100)})
+	}
+	return info, nil
+}
+
+func (r *testDVSReader) GetOperatorInfoByID(operatorID types.OperatorID) (types.OperatorInfo, error) {
+	r.infoReads.Add(1)
+	for _, operator := range r.operators {
+		if operator.id == operatorID {
+			return r.operatorInfo(operator), nil
+		}
+	}
+	return types.OperatorInfo{}, fmt.Errorf("operator %x not found", operatorID)
+}
+
+func (r *testDVSReader) GetCheckSignaturesIndices(_ uint64, _ uint32, _ types.GroupNumbers,
+	_ []types.OperatorID) (reader.CheckSignaturesIndices, error) {
+	return reader.CheckSignaturesIndices{}, nil
+}
+
+func newTestAggregator(dvsReader reader.DVSReader) *AggregatorRPCServer {
+	return newTestAggregatorWithStore(dvsReader, aggstore.NewTaskStore(dbm.NewMemDB()))
+}
+
+func newTestAggregatorWithStore(dvsReader reader.DVSReader, store *aggstore.TaskStore) *AggregatorRPCServer {
+	return &AggregatorRPCServer{
+		tasks:                   make(map[string]*Task),
+		results:                 make(map[string]*finalizedResult),
+		resultCacheTTL:          aggcfg.DefaultResultCacheTTL,
+		tasksLocks:              make(map[string]*sync.Mutex),
+		operatorResponseTimeout: aggcfg.DefaultOperatorResponseTimeout,
+		chainConfigs:            map[uint64]*interactorcfg.DVSConfig{testChainID: {}},
+		dvsReader:               dvsReader,
+		metrics:                 NopMetrics(),
+		store:                   store,
+		resultRetention:         aggcfg.DefaultResultRetention,
+		activeTasksPerChain:     make(map[int64]int),
+		pendingRestores:         make(map[string]struct{}),
+		shutdownDrainTimeout:    aggcfg.DefaultShutdownDrainTimeout,
+		snapshots:               newSnapshotCache(aggcfg.DefaultOperatorSnapshotCacheSize),
+		logger:                  log.NewNopLogger(),
+	}
+}
+
+// signedResponses builds the response of every operator for a request that
+// needs all of them to sign before quorum is reached
+func signedResponses(t testing.TB, dvsReader *testDVSReader, round int) []*aggtypes.ResponseWithSignature {
+	request := avsitypes.DVSRequest{
+		Data:                      []byte(fmt.Sprintf("request-%d", round)),
+		Height:                    100,
+		ChainId:                   testChainID,
+		GroupNumbers:              []uint32{uint32(testGroupNumber)},
+		GroupThresholdPercentages: []uint32{100},
+	}
+	data := []byte(fmt.Sprintf("response-%d", round))
+	digest := [32]byte(crypto.Keccak256(data))
+
+	responses := make([]*aggtypes.ResponseWithSignature, 0, len(dvsReader.operators))
+	for _, operator := range dvsReader.operators {
+		response := &aggtypes.ResponseWithSignature{
+			Data:        data,
+			Digest:      digest,
+			Signature:   operator.keyPair.SignMessage(digest),
+			OperatorID:  operator.id,
+			RequestData: request,
+		}
+		require.NoError(t, response.SignOperator(operator.ecdsaKey))
+		responses = append(responses, response)
+	}
+	return responses
+}
+
+// BenchmarkCollectResponseSignature200Operators submits the signatures of 200
+// operators concurrently and requires the whole round, which needs every
+// signature to reach quorum, to finish within the operator response timeout.
+func BenchmarkCollectResponseSignature200Operators(b *testing.B) {
+	dvsReader := newTestDVSReader(b, 200)
+	ra := newTestAggregator(dvsReader)
+
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		responses := signedResponses(b, dvsReader, i)
+		b.StartTimer()
+
+		start := time.Now()
+		results := make([]aggtypes.ValidatedResponse, len(responses))
+		errs := make([]error, len(responses))
+		var wg sync.WaitGroup
+		for j, response := range responses {
+			wg.Add(1)
+			go func(j int, response *aggtypes.ResponseWithSignature) {
+				defer wg.Done()
+				errs[j] = ra.CollectResponseSignature(response, &results[j])
+			}(j, response)
+		}
+		wg.Wait()
+		elapsed := time.Since(start)
+
+		b.StopTimer()
+		for j := range responses {
+			require.NoError(b, errs[j])
+			require.Nil(b, results[j].Err)
+		}
+		if elapsed >= ra.operatorResponseTimeout {
+			b.Fatalf("aggregating 200 operators took %s, longer than the %s timeout",
+				elapsed, ra.operatorResponseTimeout)
+		}
+		b.StartTimer()
+	}
+}
+
+// collectAll submits the responses concurrently and returns their results
+func collectAll(t *testing.T, ra *AggregatorRPCServer,
+	responses []*aggtypes.ResponseWithSignature) []aggtypes.ValidatedResponse {
+	results := make([]aggtypes.ValidatedResponse, len(responses))
+	errs := make([]error, len(responses))
+	var wg sync.WaitGroup
+	for i, response := range responses {
+		wg.Add(1)
+		go func(i int, response *aggtypes.ResponseWithSignature) {
+			defer wg.Done()
+			errs[i] = ra.CollectResponseSignature(response, &results[i])
+		}(i, response)
+	}
+	wg.Wait()
+
+	for i := range responses {
+		require.NoError(t, errs[i])
+	}
+	return results
+}
+
+func TestRestoreTasksResumesCollection(t *testing.T) {
+	dvsReader := newTestDVSReader(t, 4)
+	store := aggstore.NewTaskStore(dbm.NewMemDB())
+	responses := signedResponses(t, dvsReader, 0)
+	taskID := hex.EncodeToString(responses[0].RequestData.Hash())
+
+	// The first aggregator collects half of the responses and goes down
+	ra := newTestAggregatorWithStore(dvsReader, store)
+	task, _, err := ra.getOrCreateTask(taskID, responses[0].RequestData, responses[0].OperatorID)
+	require.NoError(t, err)
+	for _, response := range responses[:2] {
+		require.False(t, ra.addResponse(task, *response))
+	}
+	task.timer.Stop()
+
+	// The restarted aggregator needs only the remaining responses to reach quorum
+	restarted := newTestAggregatorWithStore(dvsReader, store)
+	require.NoError(t, restarted.restoreTasks())
+
+	var status aggtypes.TaskStatus
+	require.NoError(t, restarted.GetTaskStatus(responses[0].RequestData.Hash(), &status))
+	require.Equal(t, aggtypes.TaskStatePending, status.State)
+	require.Equal(t, 2, status.ResponsesCount)
+
+	for _, result := range collectAll(t, restarted, responses[2:]) {
+		require.Nil(t, result.Err)
+		require.Equal(t, responses[0].Data, result.Data)
+	}
+
+	require.NoError(t, restarted.GetTaskStatus(responses[0].RequestData.Hash(), &status))
+	require.Equal(t, aggtypes.TaskStateFinalized, status.State)
+	require.Nil(t, status.Result.Err)
+	require.Equal(t, responses[0].Data, status.Result.Data)
+
+	storedTasks, err := store.LoadTasks()
+	require.NoError(t, err)
+	require.Empty(t, storedTasks)
+}
+
+func TestRestoreTasksFinalizesExpiredTasks(t *testing.T) {
+	dvsReader := newTestDVSReader(t, 4)
+	store := aggstore.NewTaskStore(dbm.NewMemDB())
+	responses := signedResponses(t, dvsReader, 0)
+	taskID := hex.EncodeToString(responses[0].RequestData.Hash())
+
+	require.NoError(t, store.SaveTask(taskID, responses[0].RequestData, time.Now().Add(-time.Second)))
+	require.NoError(t, store.SaveResponse(taskID, responses[0]))
+
+	ra := newTestAggregatorWithStore(dvsReader, store)
+	require.NoError(t, ra.restoreTasks())
+
+	var err error
+	var result *aggtypes.ValidatedResponse
+	require.Eventually(t, func() bool {
+		result, err = store.LoadResult(taskID)
+		return err == nil && result != nil
+	}, time.Second, 10*time.Millisecond)
+
+	// A single response out of four does not meet the threshold
+	require.NotNil(t, result.Err)
+	require.Equal(t, errcode.AggregationFailed, result.Err.Code)
+}
+
+func TestRestoreTasksKeepsTasksOnReadErrors(t *testing.T) {
+	dvsReader := newTestDVSReader(t, 4)
+	store := aggstore.NewTaskStore(dbm.NewMemDB())
+	responses := signedResponses(t, dvsReader, 0)
+	taskID := hex.EncodeToString(responses[0].RequestData.Hash())
+	require.NoError(t, store.SaveTask(taskID, responses[0].RequestData, time.Now().Add(time.Minute)))
+	for _, response := range responses[:2] {
+		require.NoError(t, store.SaveResponse(taskID, response))
+	}
+
+	// a task for a chain the aggregator has no config for is invalid
+	invalid := responses[0].RequestData
+	invalid.ChainId = testChainID + 1
+	invalidID := hex.EncodeToString(invalid.Hash())
+	require.NoError(t, store.SaveTask(invalidID, invalid, time.Now().Add(time.Minute)))
+
+	// the chain cannot be read on start, the valid task is kept for later
+	dvsReader.unavailable.Store(true)
+	ra := newTestAggregatorWithStore(dvsReader, store)
+	require.NoError(t, ra.restoreTasks())
+	require.Empty(t, ra.tasks)
+	storedTasks, err := store.LoadTasks()
+	require.NoError(t, err)
+	require.Len(t, storedTasks, 1)
+	require.Equal(t, taskID, storedTasks[0].TaskID)
+	require.Len(t, storedTasks[0].Responses, 2)
+
+	// once the chain is back, the remaining responses complete the task
+	// together with the stored ones
+	dvsReader.unavailable.Store(false)
+	for _, result := range collectAll(t, ra, responses[2:]) {
+		require.Nil(t, result.Err)
+		require.Equal(t, responses[0].Data, result.Data)
+	}
+	require.Empty(t, ra.pendingRestores)
+}
+
+func TestLateResponseGetsCachedResult(t *testing.T) {
+	dvsReader := newTestDVSReader(t, 4)
+	ra := newTestAggregator(dvsReader)
+	responses := signedResponses(t, dvsReader, 0)
+	// three out of four operators are enough for quorum
+	for i, response := range responses {
+		response.RequestData.GroupThresholdPercentages = []uint32{75}
+		require.NoError(t, response.SignOperator(dvsReader.operators[i].ecdsaKey))
+	}
+	taskID := hex.EncodeToString(responses[0].RequestData.Hash())
+
+	results := collectAll(t, ra, responses[:3])
+	for _, result := range results {
+		require.Nil(t, result.Err)
+		require.False(t, result.NotIncluded)
+	}
+
+	ra.tasksMutex.RLock()
+	require.NotContains(t, ra.tasks, taskID)
+	require.NotContains(t, ra.tasksLocks, taskID)
+	ra.tasksMutex.RUnlock()
+
+	// The late operator gets the same result right away without a new task
+	start := time.Now()
+	late := collectAll(t, ra, responses[3:])[0]
+	require.Less(t, time.Since(start), ra.operatorResponseTimeout)
+	require.Nil(t, late.Err)
+	require.True(t, late.NotIncluded)
+	require.Equal(t, results[0].Data, late.Data)
+	require.Equal(t, results[0].SignersAggSigG1.Serialize(), late.SignersAggSigG1.Serialize())
+
+	// An operator that was part of the aggregate is not marked
+	again := collectAll(t, ra, responses[:1])[0]
+	require.False(t, again.NotIncluded)
+
+	ra.tasksMutex.RLock()
+	require.NotContains(t, ra.tasks, taskID)
+	ra.tasksMutex.RUnlock()
+
+	ra.evictResults(time.Now().Add(ra.resultCacheTTL + time.Second))
+	ra.tasksMutex.RLock()
+	require.Empty(t, ra.results)
+	require.Empty(t, ra.tasksLocks)
+	ra.tasksMutex.RUnlock()
+}
+
+func TestLateResponseAfterRestartGetsStoredResult(t *testing.T) {
+	dvsReader := newTestDVSReader(t, 4)
+	store := aggstore.NewTaskStore(dbm.NewMemDB())
+	ra := newTestAggregatorWithStore(dvsReader, store)
+	responses := signedResponses(t, dvsReader, 0)
+	// three out of four operators are enough for quorum
+	for i, response := range responses {
+		response.RequestData.GroupThresholdPercentages = []uint32{75}
+		require.NoError(t, response.SignOperator(dvsReader.operators[i].ecdsaKey))
+	}
+	taskID := hex.EncodeToString(responses[0].RequestData.Hash())
+
+	results := collectAll(t, ra, responses[:3])
+	require.Nil(t, results[0].Err)
+
+	// The restarted aggregator answers the late operator from the store
+	// without reading the chain again or waiting for a new task
+	restarted := newTestAggregatorWithStore(dvsReader, store)
+	stateReads := dvsReader.stateReads.Load()
+	start := time.Now()
+	late := collectAll(t, restarted, responses[3:])[0]
+	require.Less(t, time.Since(start), restarted.operatorResponseTimeout)
+	require.Nil(t, late.Err)
+	require.True(t, late.NotIncluded)
+	require.Equal(t, results[0].Data, late.Data)
+	require.Equal(t, stateReads, dvsReader.stateReads.Load())
+
+	restarted.tasksMutex.RLock()
+	require.Empty(t, restarted.tasks)
+	require.Contains(t, restarted.results, taskID)
+	restarted.tasksMutex.RUnlock()
+
+	// The stored result is kept as it was
+	stored, err := store.LoadResult(taskID)
+	require.NoError(t, err)
+	require.Nil(t, stored.Err)
+	require.Equal(t, results[0].Data, stored.Data)
+}
+
+func TestListTasksAndGetTaskResult(t *testing.T) {
+	dvsReader := newTestDVSReader(t, 4)
+	ra := newTestAggregator(dvsReader)
+	responses := signedResponses(t, dvsReader, 0)
+	requestHash := responses[0].RequestData.Hash()
+	taskID := hex.EncodeToString(requestHash)
+
+	task, _, err := ra.getOrCreateTask(taskID, responses[0].RequestData, responses[0].OperatorID)
+	require.NoError(t, err)
+	for _, response := range responses[:2] {
+		require.False(t, ra.addResponse(task, *response))
+	}
+
+	var tasks []aggtypes.TaskInfo
+	require.NoError(t, ra.ListTasks(struct{}{}, &tasks))
+	require.Len(t, tasks, 1)
+
+	info := tasks[0]
+	require.Equal(t, []byte(requestHash), info.RequestHash)
+	require.Equal(t, int64(testChainID), info.ChainID)
+	require.Equal(t, uint32(100), info.BlockNumber)
+	require.Equal(t, []uint32{uint32(testGroupNumber)}, info.GroupNumbers)
+	require.Equal(t, []uint32{100}, info.ThresholdPercentages)
+	require.Equal(t, task.deadline, info.Deadline)
+	require.Equal(t, big.NewInt(400), info.TotalStakePerGroup[uint32(testGroupNumber)])
+	require.Len(t, info.Signers, 2)
+	require.Len(t, info.MissingOperators, 2)
+	require.Len(t, info.Digests, 1)
+	require.Equal(t, responses[0].Digest, info.Digests[0].Digest)
+	require.Equal(t, big.NewInt(200), info.Digests[0].StakePerGroup[uint32(testGroupNumber)])
+
+	var result aggtypes.ValidatedResponse
+	require.ErrorIs(t, ra.GetTaskResult(requestHash, &result), aggtypes.ErrTaskResultNotFound)
+
+	collectAll(t, ra, responses[2:])
+
+	require.NoError(t, ra.ListTasks(struct{}{}, &tasks))
+	require.Empty(t, tasks)
+	require.NoError(t, ra.GetTaskResult(requestHash, &result))
+	require.Nil(t, result.Err)
+	require.Equal(t, responses[0].Data, result.Data)
+}
+
+func TestCollectResponseSignatureRejectsUnauthenticated(t *testing.T) {
+	dvsReader := newTestDVSReader(t, 2)
+	ra := newTestAggregator(dvsReader)
+	responses := signedResponses(t, dvsReader, 0)
+
+	unsigned := *responses[0]
+	unsigned.OperatorSignature = nil
+
+	// signed by the second operator while claiming to be the first one
+	forged := *responses[0]
+	require.NoError(t, forged.SignOperator(dvsReader.operators[1].ecdsaKey))
+
+	for _, response := range []*aggtypes.ResponseWithSignature{&unsigned, &forged} {
+		var result aggtypes.ValidatedResponse
+		require.NoError(t, ra.CollectResponseSignature(response, &result))
+		require.NotNil(t, result.Err)
+		require.Equal(t, errcode.Unauthenticated, result.Err.Code)
+	}
+
+	// no task was created for the rejected submissions
+	require.Empty(t, ra.tasks)
+	tasks, err := ra.store.LoadTasks()
+	require.NoError(t, err)
+	require.Empty(t, tasks)
+}
+
+func TestCollectResponseSignatureRejectsInvalidSignature(t *testing.T) {
+	dvsReader := newTestDVSReader(t, 2)
+	ra := newTestAggregator(dvsReader)
+	responses := signedResponses(t, dvsReader, 0)
+	requestHash := responses[0].RequestData.Hash()
+
+	// the BLS signature is made with a key the operator did not register
+	other, err := bls.GenRandomBlsKeys()
+	require.NoError(t, err)
+	invalid := *responses[0]
+	invalid.Signature = other.SignMessage(invalid.Digest)
+	require.NoError(t, invalid.SignOperator(dvsReader.operators[0].ecdsaKey))
+
+	var result aggtypes.ValidatedResponse
+	require.NoError(t, ra.CollectResponseSignature(&invalid, &result))
+	require.NotNil(t, result.Err)
+	require.Equal(t, errcode.InvalidSignature, result.Err.Code)
+
+	// a signature at infinity is rejected before it is verified
+	infinity := *responses[0]
+	infinity.Signature = &bls.Signature{G1Point: bls.NewZeroG1Point()}
+	require.NoError(t, infinity.SignOperator(dvsReader.operators[0].ecdsaKey))
+	result = aggtypes.ValidatedResponse{}
+	require.NoError(t, ra.CollectResponseSignature(&infinity, &result))
+	require.NotNil(t, result.Err)
+	require.Equal(t, errcode.InvalidSignature, result.Err.Code)
+
+	// the rejected responses are not counted toward the stake of the task
+	ra.tasksMutex.RLock()
+	task := ra.tasks[hex.EncodeToString(requestHash)]
+	ra.tasksMutex.RUnlock()
+	require.NotNil(t, task)
+	task.mtx.Lock()
+	require.Empty(t, task.operatorResponses)
+	require.Empty(t, task.signedStakePerDigest)
+	task.mtx.Unlock()
+
+	var status aggtypes.TaskStatus
+	require.NoError(t, ra.GetTaskStatus(requestHash, &status))
+	require.Equal(t, aggtypes.TaskStatePending, status.State)
+	require.Zero(t, status.ResponsesCount)
+
+	// the valid responses of both operators still reach quorum
+	for _, result := range collectAll(t, ra, responses) {
+		require.Nil(t, result.Err)
+		require.False(t, result.NotIncluded)
+	}
+}
+
+func TestCollectResponseSignatureAdmissionControl(t *testing.T) {
+	dvsReader := newTestDVSReader(t, 2)
+	ra := newTestAggregator(dvsReader)
+	ra.limits = admissionLimits{
+		maxConcurrentTasks:         2,
+		maxConcurrentTasksPerChain: 1,
+		maxRequestDataSize:         16,
+		maxGroupNumbers:            1,
+	}
+
+	collectErrCode := func(response *aggtypes.ResponseWithSignature, key *ecdsa.PrivateKey) int {
+		require.NoError(t, response.SignOperator(key))
+		var result aggtypes.ValidatedResponse
+		require.NoError(t, ra.CollectResponseSignature(response, &result))
+		require.NotNil(t, result.Err)
+		return result.Err.Code
+	}
+	operatorKey := dvsReader.operators[0].ecdsaKey
+
+	tooLarge := signedResponses(t, dvsReader, 0)[0]
+	tooLarge.RequestData.Data = make([]byte, 17)
+	require.Equal(t, errcode.RequestTooLarge, collectErrCode(tooLarge, operatorKey))
+
+	tooManyGroups := signedResponses(t, dvsReader, 0)[0]
+	tooManyGroups.RequestData.GroupNumbers = []uint32{0, 1}
+	tooManyGroups.RequestData.GroupThresholdPercentages = []uint32{100, 100}
+	require.Equal(t, errcode.TooManyGroups, collectErrCode(tooManyGroups, operatorKey))
+
+	// an operator outside the requested groups cannot create a task
+	outsider, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	notMember := signedResponses(t, dvsReader, 0)[0]
+	notMember.OperatorID = pelltypes.OperatorIDFromAddress(crypto.PubkeyToAddress(outsider.PublicKey))
+	require.Equal(t, errcode.NotGroupMember, collectErrCode(notMember, outsider))
+	require.Empty(t, ra.tasks)
+	require.Zero(t, ra.activeTasks)
+
+	// a task of the chain is in flight, another one for the same chain is rejected
+	first := signedResponses(t, dvsReader, 1)[0]
+	_, _, err = ra.getOrCreateTask(hex.EncodeToString(first.RequestData.Hash()), first.RequestData, first.OperatorID)
+	require.NoError(t, err)
+	second := signedResponses(t, dvsReader, 2)[0]
+	require.Equal(t, errcode.TooManyTasks, collectErrCode(second, operatorKey))
+	require.Len(t, ra.tasks, 1)
+
+	// the slot is released once the task is finalized
+	ra.finalizeTask(hex.EncodeToString(first.RequestData.Hash()))
+	require.Zero(t, ra.activeTasks)
+	_, _, err = ra.getOrCreateTask(hex.EncodeToString(second.RequestData.Hash()), second.RequestData, second.OperatorID)
+	require.NoError(t, err)
+	for _, task := range ra.tasks {
+		task.timer.Stop()
+	}
+}
+
+func TestAggregateSignaturesExcludesFaultySigner(t *testing.T) {
+	dvsReader := newTestDVSReader(t, 4)
+	// the first operator registered a G1 key that does not match its G2 key,
+	// its signature verifies but the aggregate would fail on-chain
+	other, err := bls.GenRandomBlsKeys()
+	require.NoError(t, err)
+	dvsReader.operators[0].registeredG1 = other.GetPubKeyG1()
+
+	ra := newTestAggregator(dvsReader)
+	responses := signedResponses(t, dvsReader, 0)
+	for i, response := range responses {
+		response.RequestData.GroupThresholdPercentages = []uint32{75}
+		require.NoError(t, response.SignOperator(dvsReader.operators[i].ecdsaKey))
+	}
+	requestHash := responses[0].RequestData.Hash()
+	taskID := hex.EncodeToString(requestHash)
+
+	// all four operators signed when the task is finalized, three of them
+	// are still enough once the faulty one is excluded
+	task, _, err := ra.getOrCreateTask(taskID, responses[0].RequestData, responses[0].OperatorID)
+	require.NoError(t, err)
+	for _, response := range responses {
+		ra.addResponse(task, *response)
+	}
+	ra.finalizeTask(taskID)
+
+	var result aggtypes.ValidatedResponse
+	require.NoError(t, ra.GetTaskResult(requestHash, &result))
+	require.Nil(t, result.Err)
+	require.Len(t, result.NonSignersPubkeysG1, 1)
+	require.NoError(t, verifyAggregate(task, &result, ResultDigest(responses[0].Digest)))
+	require.True(t, task.signers[responses[1].OperatorID])
+	require.False(t, task.signers[responses[0].OperatorID])
+}
+
+func TestAggregateSignaturesFailsBelowThresholdAfterExclusion(t *testing.T) {
+	dvsReader := newTestDVSReader(t, 4)
+	other, err := bls.GenRandomBlsKeys()
+	require.NoError(t, err)
+	dvsReader.operators[0].registeredG1 = other.GetPubKeyG1()
+
+	ra := newTestAggregator(dvsReader)
+	responses := signedResponses(t, dvsReader, 0)
+	results := collectAll(t, ra, responses)
+	for _, result := range results {
+		require.NotNil(t, result.Err)
+		require.Equal(t, errcode.AggregationFailed, result.Err.Code)
+	}
+}
+
+func TestConflictingDigestsRecordEvidence(t *testing.T) {
+	dvsReader := newTestDVSReader(t, 4)
+	ra := newTestAggregator(dvsReader)
+	responses := signedResponses(t, dvsReader, 0)
+	requestHash := responses[0].RequestData.Hash()
+	taskID := hex.EncodeToString(requestHash)
+
+	task, _, err := ra.getOrCreateTask(taskID, responses[0].RequestData, responses[0].OperatorID)
+	require.NoError(t, err)
+	defer task.timer.Stop()
+	require.False(t, ra.addResponse(task, *responses[0]))
+
+	// the same operator signs another response for the request
+	operator := dvsReader.operators[0]
+	conflicting := *responses[0]
+	conflicting.Data = []byte("conflicting")
+	conflicting.Digest = [32]byte(crypto.Keccak256(conflicting.Data))
+	conflicting.Signature = operator.keyPair.SignMessage(conflicting.Digest)
+	require.NoError(t, conflicting.SignOperator(operator.ecdsaKey))
+	require.False(t, ra.addResponse(task, conflicting))
+	// resubmitting the same response is not equivocation
+	require.False(t, ra.addResponse(task, *responses[0]))
+
+	var evidence []aggtypes.Evidence
+	require.NoError(t, ra.ListEvidence(aggtypes.EvidenceQuery{RequestHash: requestHash}, &evidence))
+	require.Len(t, evidence, 1)
+	require.Equal(t, [32]byte(operator.id), evidence[0].OperatorID)
+	require.Equal(t, responses[0].Digest, evidence[0].First.Digest)
+	require.Equal(t, conflicting.Digest, evidence[0].Second.Digest)
+	require.NoError(t, evidence[0].Second.VerifyOperator())
+
+	// the first response is the one kept in the task
+	require.Equal(t, responses[0].Digest, task.operatorResponses[operator.id].Digest)
+
+	require.NoError(t, ra.ListEvidence(aggtypes.EvidenceQuery{OperatorID: responses[1].OperatorID[:]}, &evidence))
+	require.Empty(t, evidence)
+}
+
+// withThreshold sets the threshold percentage of the request of every response
+func withThreshold(t *testing.T, dvsReader *testDVSReader,
+	responses []*aggtypes.ResponseWithSignature, percentage uint32) {
+	for i, response := range responses {
+		response.RequestData.GroupThresholdPercentages = []uint32{percentage}
+		require.NoError(t, response.SignOperator(dvsReader.operators[i].ecdsaKey))
+	}
+}
+
+// withData signs the response of the operator again for other data
+func withData(t *testing.T, operator testOperator, response *aggtypes.ResponseWithSignature,
+	data []byte) *aggtypes.ResponseWithSignature {
+	signed := *response
+	signed.Data = data
+	signed.Digest = [32]byte(crypto.Keccak256(data))
+	signed.Signature = operator.keyPair.SignMessage(signed.Digest)
+	require.NoError(t, signed.SignOperator(operator.ecdsaKey))
+	return &signed
+}
+
+func TestSplitDigestsMissThreshold(t *testing.T) {
+	dvsReader := newTestDVSReader(t, 4)
+	ra := newTestAggregator(dvsReader)
+	responses := signedResponses(t, dvsReader, 0)
+	withThreshold(t, dvsReader, responses, 75)
+	// half of the operators sign other data
+	for i := 2; i < len(responses); i++ {
+		responses[i] = withData(t, dvsReader.operators[i], responses[i], []byte("other"))
+	}
+	taskID := hex.EncodeToString(responses[0].RequestData.Hash())
+
+	task, _, err := ra.getOrCreateTask(taskID, responses[0].RequestData, responses[0].OperatorID)
+	require.NoError(t, err)
+	defer task.timer.Stop()
+	for _, response := range responses {
+		require.False(t, ra.addResponse(task, *response))
+	}
+
+	// each digest holds half of the stake, neither reaches the threshold
+	require.Len(t, task.signedStakePerDigest, 2)
+	for _, response := range []*aggtypes.ResponseWithSignature{responses[0], responses[2]} {
+		signed := task.signedStakePerDigest[ResultDigest(response.Digest)][testGroupNumber]
+		require.Zero(t, signed.Cmp(big.NewInt(200)))
+	}
+	_, ok := ra.selectDigest(task)
+	require.False(t, ok)
+
+	ra.finalizeTask(taskID)
+	var result aggtypes.ValidatedResponse
+	require.NoError(t, ra.GetTaskResult(responses[0].RequestData.Hash(), &result))
+	require.NotNil(t, result.Err)
+	require.Equal(t, errcode.AggregationFailed, result.Err.Code)
+}
+
+func TestDigestReachingThresholdIsSelected(t *testing.T) {
+	dvsReader := newTestDVSReader(t, 4)
+	ra := newTestAggregator(dvsReader)
+	responses := signedResponses(t, dvsReader, 0)
+	withThreshold(t, dvsReader, responses, 75)
+	// the first operator signs other data, the other three agree
+	responses[0] = withData(t, dvsReader.operators[0], responses[0], []byte("other"))
+	requestHash := responses[0].RequestData.Hash()
+	taskID := hex.EncodeToString(requestHash)
+
+	task, _, err := ra.getOrCreateTask(taskID, responses[0].RequestData, responses[0].OperatorID)
+	require.NoError(t, err)
+	require.False(t, ra.addResponse(task, *responses[0]))
+	require.False(t, ra.addResponse(task, *responses[1]))
+	require.True(t, ra.addResponse(task, *responses[2]))
+
+	agreed := ResultDigest(responses[1].Digest)
+	require.Zero(t, task.signedStakePerDigest[agreed][testGroupNumber].Cmp(big.NewInt(200)))
+	require.Zero(t, task.signedStakePerDigest[ResultDigest(responses[0].Digest)][testGroupNumber].Cmp(big.NewInt(100)))
+	digest, ok := ra.selectDigest(task)
+	require.True(t, ok)
+	require.Equal(t, agreed, digest)
+
+	ra.finalizeTask(taskID)
+	var result aggtypes.ValidatedResponse
+	require.NoError(t, ra.GetTaskResult(requestHash, &result))
+	require.Nil(t, result.Err)
+	require.Equal(t, responses[1].Data, result.Data)
+	require.NoError(t, verifyAggregate(task, &result, agreed))
+	require.False(t, task.signers[responses[0].OperatorID])
+	require.True(t, task.signers[responses[1].OperatorID])
+}
+
+func TestDigestTieBrokenByByteOrder(t *testing.T) {
+	dvsReader := newTestDVSReader(t, 4)
+	ra := newTestAggregator(dvsReader)
+	responses := signedResponses(t, dvsReader, 0)
+	withThreshold(t, dvsReader, responses, 50)
+	for i := 2; i < len(responses); i++ {
+		responses[i] = withData(t, dvsReader.operators[i], responses[i], []byte("other"))
+	}
+	// lower holds the responses whose digest sorts first
+	lower, higher := responses[:2], responses[2:]
+	if bytes.Compare(higher[0].Digest[:], lower[0].Digest[:]) < 0 {
+		lower, higher = higher, lower
+	}
+	requestHash := responses[0].RequestData.Hash()
+	taskID := hex.EncodeToString(requestHash)
+
+	// the higher digest reaches the threshold first, and the lower one
+	// reaches it too before the task is finalized
+	task, _, err := ra.getOrCreateTask(taskID, responses[0].RequestData, responses[0].OperatorID)
+	require.NoError(t, err)
+	require.False(t, ra.addResponse(task, *higher[0]))
+	require.False(t, ra.addResponse(task, *lower[0]))
+	require.True(t, ra.addResponse(task, *higher[1]))
+	require.False(t, ra.addResponse(task, *lower[1]))
+
+	digest, ok := ra.selectDigest(task)
+	require.True(t, ok)
+	require.Equal(t, ResultDigest(lower[0].Digest), digest)
+
+	ra.finalizeTask(taskID)
+	var result aggtypes.ValidatedResponse
+	require.NoError(t, ra.GetTaskResult(requestHash, &result))
+	require.Nil(t, result.Err)
+	require.Equal(t, lower[0].Data, result.Data)
+	require.NoError(t, verifyAggregate(task, &result, digest))
+}
+
+func TestLateConflictingDigestRecordsEvidence(t *testing.T) {
+	dvsReader := newTestDVSReader(t, 4)
+	ra := newTestAggregator(dvsReader)
+	responses := signedResponses(t, dvsReader, 0)
+	withThreshold(t, dvsReader, responses, 75)
+	requestHash := responses[0].RequestData.Hash()
+
+	// the task is finalized early, before the last operator answers
+	for _, result := range collectAll(t, ra, responses[:3]) {
+		require.Nil(t, result.Err)
+	}
+
+	// after finalization the first operator signs other data, and the last
+	// one, which did not answer before, does too
+	late := []*aggtypes.ResponseWithSignature{
+		withData(t, dvsReader.operators[0], responses[0], []byte("conflicting")),
+		withData(t, dvsReader.operators[3], responses[3], []byte("conflicting")),
+	}
+	// a conflicting response whose signature does not verify is no evidence
+	forged := *withData(t, dvsReader.operators[1], responses[1], []byte("conflicting"))
+	forged.Signature = dvsReader.operators[0].keyPair.SignMessage(forged.Digest)
+	require.NoError(t, forged.SignOperator(dvsReader.operators[1].ecdsaKey))
+	late = append(late, &forged)
+
+	for _, response := range late {
+		var result aggtypes.ValidatedResponse
+		require.NoError(t, ra.CollectResponseSignature(response, &result))
+		require.Nil(t, result.Err)
+	}
+
+	var evidence []aggtypes.Evidence
+	require.NoError(t, ra.ListEvidence(aggtypes.EvidenceQuery{RequestHash: requestHash}, &evidence))
+	require.Len(t, evidence, 1)
+	require.Equal(t, [32]byte(dvsReader.operators[0].id), evidence[0].OperatorID)
+	require.Equal(t, responses[0].Digest, evidence[0].First.Digest)
+	require.Equal(t, late[0].Digest, evidence[0].Second.Digest)
+}
+
+func TestOnStopDrainsInFlightTasks(t *testing.T) {
+	dvsReader := newTestDVSReader(t, 2)
+	db := dbm.NewMemDB()
+	ra := newTestAggregatorWithStore(dvsReader, aggstore.NewTaskStore(db))
+	ra.server = rpc.NewServer()
+	ra.rpcAddress = "127.0.0.1:0"
+	require.NoError(t, ra.OnStart())
+
+	// an operator waits over net/rpc on a task that has not reached quorum
+	client, err := rpc.Dial("tcp", ra.listener.Addr().String())
+	require.NoError(t, err)
+	defer client.Close()
+	responses := signedResponses(t, dvsReader, 0)
+	call := client.Go(RPCServerAggregatorMethod, responses[0], new(aggtypes.ValidatedResponse), nil)
+	require.Eventually(t, func() bool {
+		ra.tasksMutex.RLock()
+		defer ra.tasksMutex.RUnlock()
+		for _, task := range ra.tasks {
+			task.mtx.Lock()
+			responses := len(task.operatorResponses)
+			task.mtx.Unlock()
+			if responses == 1 {
+				return true
+			}
+		}
+		return false
+	}, time.Second, 10*time.Millisecond)
+
+	ra.OnStop()
+
+	// the answer was written before OnStop returned
+	ra.rpcCalls.mtx.Lock()
+	require.Zero(t, ra.rpcCalls.count)
+	ra.rpcCalls.mtx.Unlock()
+	select {
+	case <-call.Done:
+		require.NoError(t, call.Error)
+		result := call.Reply.(*aggtypes.ValidatedResponse)
+		require.NotNil(t, result.Err)
+		require.Equal(t, errcode.ShuttingDown, result.Err.Code)
+	case <-time.After(time.Second):
+		t.Fatal("waiting operator was not released on stop")
+	}
+	require.Empty(t, ra.tasks)
+	require.Zero(t, ra.activeTasks)
+
+	// no new task is created once stopping
+	var result aggtypes.ValidatedResponse
+	require.NoError(t, ra.CollectResponseSignature(signedResponses(t, dvsReader, 1)[0], &result))
+	require.NotNil(t, result.Err)
+	require.Equal(t, errcode.ShuttingDown, result.Err.Code)
+
+	// the aborted task is not restored, the operators submitting again
+	// after the restart start a new task that aggregates
+	restarted := newTestAggregatorWithStore(dvsReader, aggstore.NewTaskStore(db))
+	require.NoError(t, restarted.restoreTasks())
+	require.Empty(t, restarted.tasks)
+	for _, result := range collectAll(t, restarted, responses) {
+		require.Nil(t, result.Err)
+		require.Equal(t, responses[0].Data, result.Data)
+	}
+}
+
+func TestLocalAggregator(t *testing.T) {
+	dvsReader := newTestDVSReader(t, 1)
+	ra := newTestAggregator(dvsReader)
+	local := NewLocalAggregator(ra)
+
+	response := signedResponses(t, dvsReader, 0)[0]
+	resultCh := make(chan aggtypes.ValidatedResponse, 1)
+	require.NoError(t, local.CollectResponseSignature(context.Background(), response, resultCh))
+	result := <-resultCh
+	require.Nil(t, result.Err)
+	require.False(t, result.NotIncluded)
+	require.Equal(t, response.Data, result.Data)
+
+	// an error answered by the aggregator is returned as an aggregation error
+	response.OperatorSignature = nil
+	err := local.CollectResponseSignature(context.Background(), response, resultCh)
+	var aggErr *aggtypes.AggregationError
+	require.ErrorAs(t, err, &aggErr)
+	require.Equal(t, errcode.Unauthenticated, aggErr.Err.Code)
+	require.Empty(t, resultCh)
+}
+
+func TestOperatorSnapshotCache(t *testing.T) {
+	dvsReader := newTestDVSReader(t, 2)
+	ra := newTestAggregator(dvsReader)
+	ra.snapshots = newSnapshotCache(1)
+
+	// tasks at the same block and groups share the snapshot and its operator infos
+	for round := 0; round < 2; round++ {
+		responses := signedResponses(t, dvsReader, round)
+		results := make([]aggtypes.ValidatedResponse, len(responses))
+		var wg sync.WaitGroup
+		for i, response := range responses {
+			wg.Add(1)
+			go func(i int, response *aggtypes.ResponseWithSignature) {
+				defer wg.Done()
+				require.NoError(t, ra.CollectResponseSignature(response, &results[i]))
+			}(i, response)
+		}
+		wg.Wait()
+		for _, result := range results {
+			require.Nil(t, result.Err)
+		}
+	}
+	require.EqualValues(t, 1, dvsReader.stateReads.Load())
+	require.EqualValues(t, len(dvsReader.operators), dvsReader.infoReads.Load())
+
+	// a task at another block evicts the least recently used snapshot
+	response := signedResponses(t, dvsReader, 2)[0]
+	response.RequestData.Height++
+	require.NoError(t, response.SignOperator(dvsReader.operators[0].ecdsaKey))
+	task, _, err := ra.getOrCreateTask(hex.EncodeToString(response.RequestData.Hash()),
+		response.RequestData, response.OperatorID)
+	require.NoError(t, err)
+	task.timer.Stop()
+	require.EqualValues(t, 2, dvsReader.stateReads.Load())
+
+	_, cached := ra.snapshots.get(newSnapshotKey(testChainID, 100, types.GroupNumbers{testGroupNumber}))
+	require.False(t, cached)
+	_, cached = ra.snapshots.get(newSnapshotKey(testChainID, 101, types.GroupNumbers{testGroupNumber}))
+	require.True(t, cached)
+}
+
+func TestCollectResponseSignatures(t *testing.T) {
+	dvsReader := newTestDVSReader(t, 2)
+	ra := newTestAggregator(dvsReader)
+
+	// every operator submits its responses to two requests at the same block
+	// in one batch, and both tasks share the operator snapshot
+	rounds := [][]*aggtypes.ResponseWithSignature{signedResponses(t, dvsReader, 0), signedResponses(t, dvsReader, 1)}
+	batches := make([][]aggtypes.ValidatedResponse, len(dvsReader.operators))
+	var wg sync.WaitGroup
+	for i := range dvsReader.operators {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			require.NoError(t, ra.CollectResponseSignatures(
+				[]*aggtypes.ResponseWithSignature{rounds[0][i], rounds[1][i]}, &batches[i]))
+		}(i)
+	}
+	wg.Wait()
+	for _, results := range batches {
+		require.Len(t, results, 2)
+		for round, result := range results {
+			require.Nil(t, result.Err)
+			require.Equal(t, rounds[round][0].Data, result.Data)
+		}
+	}
+	require.EqualValues(t, 1, dvsReader.stateReads.Load())
+
+	// a tampered signature is rejected without failing the rest of the batch
+	dvsReader = newTestDVSReader(t, 1)
+	ra = newTestAggregator(dvsReader)
+	valid := signedResponses(t, dvsReader, 0)[0]
+	tampered := signedResponses(t, dvsReader, 1)[0]
+	tampered.Signature = dvsReader.operators[0].keyPair.SignMessage([32]byte{1})
+	require.NoError(t, tampered.SignOperator(dvsReader.operators[0].ecdsaKey))
+
+	var results []aggtypes.ValidatedResponse
+	require.NoError(t, ra.CollectResponseSignatures([]*aggtypes.ResponseWithSignature{valid, tampered}, &results))
+	require.Len(t, results, 2)
+	require.Nil(t, results[0].Err)
+	require.Equal(t, errcode.InvalidSignature, results[1].Err.Code)
+
+	// batches over the limit are rejected as a whole
+	ra.limits.maxBatchSize = 1
+	require.NoError(t, ra.CollectResponseSignatures([]*aggtypes.ResponseWithSignature{valid, tampered}, &results))
+	require.Len(t, results, 2)
+	for _, result := range results {
+		require.Equal(t, errcode.BatchTooLarge, result.Err.Code)
+	}
+}
@@ -0,0 +1,185 @@
+package rpc
+
+import (
+	"container/list"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/0xPellNetwork/pelldvs-interactor/interactor/reader"
+	"github.com/0xPellNetwork/pelldvs-interactor/types"
+)
+
+// Lookups of the snapshot cache, used as the lookup label of the
+// SnapshotCacheHits and SnapshotCacheMisses metrics.
+const (
+	snapshotLookupOperatorState = "operator_state"
+	snapshotLookupOperatorInfo  = "operator_info"
+)
+
+// operatorSnapshot is the operator and group state of a set of groups at a
+// block. Historical state does not change, so a snapshot is read once and
+// shared by every task of the same chain, block and groups. Its state must
+// not be modified.
+type operatorSnapshot struct {
+	operatorsDvsStateDict map[types.OperatorID]types.OperatorDVSState
+	groupsDvsStateDict    map[types.GroupNumber]types.GroupDVSState
+	operatorStateInfo     *reader.OperatorStateInfo
+
+	mtx           sync.Mutex
+	operatorInfos map[types.OperatorID]types.OperatorInfo // filled as operators are looked up
+}
+
+func newOperatorSnapshot(operatorsDvsStateDict map[types.OperatorID]types.OperatorDVSState,
+	groupsDvsStateDict map[types.GroupNumber]types.GroupDVSState,
+	operatorStateInfo *reader.OperatorStateInfo) *operatorSnapshot {
+	return &operatorSnapshot{
+		operatorsDvsStateDict: operatorsDvsStateDict,
+		groupsDvsStateDict:    groupsDvsStateDict,
+		operatorStateInfo:     operatorStateInfo,
+		operatorInfos:         make(map[types.OperatorID]types.OperatorInfo),
+	}
+}
+
+func (s *operatorSnapshot) operatorInfo(operatorID types.OperatorID) (types.OperatorInfo, bool) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	info, ok := s.operatorInfos[operatorID]
+	return info, ok
+}
+
+func (s *operatorSnapshot) setOperatorInfo(operatorID types.OperatorID, info types.OperatorInfo) {
+	s.mtx.Lock()
+	s.operatorInfos[operatorID] = info
+	s.mtx.Unlock()
+}
+
+// snapshotKey identifies the snapshot of the requested groups, in request
+// order, at a block of a chain
+type snapshotKey struct {
+	chainID     uint64
+	blockNumber uint32
+	groups      string
+}
+
+func newSnapshotKey(chainID uint64, blockNumber uint32, groupNumbers types.GroupNumbers) snapshotKey {
+	groups := make([]byte, len(groupNumbers))
+	for i, groupNumber := range groupNumbers {
+		groups[i] = byte(groupNumber)
+	}
+	return snapshotKey{chainID: chainID, blockNumber: blockNumber, groups: string(groups)}
+}
+
+// snapshotCache keeps the most recently used operator snapshots, evicting
+// the least recently used one when full
+type snapshotCache struct {
+	mtx     sync.Mutex
+	size    int
+	entries *list.List // of *snapshotEntry, most recently used first
+	index   map[snapshotKey]*list.Element
+}
+
+type snapshotEntry struct {
+	key      snapshotKey
+	snapshot *operatorSnapshot
+}
+
+func newSnapshotCache(size int) *snapshotCache {
+	return &snapshotCache{
+		size:    size,
+		entries: list.New(),
+		index:   make(map[snapshotKey]*list.Element, size),
+	}
+}
+
+// get returns the snapshot cached for the key and marks it as used
+func (c *snapshotCache) get(key snapshotKey) (*operatorSnapshot, bool) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	elem, ok := c.index[key]
+	if !ok {
+		return nil, false
+	}
+	c.entries.MoveToFront(elem)
+	return elem.Value.(*snapshotEntry).snapshot, true
+}
+
+// add caches the snapshot for the key and returns the number of cached snapshots
+func (c *snapshotCache) add(key snapshotKey, snapshot *operatorSnapshot) int {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if elem, ok := c.index[key]; ok {
+		elem.Value.(*snapshotEntry).snapshot = snapshot
+		c.entries.MoveToFront(elem)
+		return c.entries.Len()
+	}
+
+	c.index[key] = c.entries.PushFront(&snapshotEntry{key: key, snapshot: snapshot})
+	for c.entries.Len() > c.size {
+		oldest := c.entries.Back()
+		c.entries.Remove(oldest)
+		delete(c.index, oldest.Value.(*snapshotEntry).key)
+	}
+	return c.entries.Len()
+}
+
+// operatorSnapshot returns the snapshot of the groups at the block, reading
+// it from the chain when it is not cached
+func (ra *AggregatorRPCServer) operatorSnapshot(chainID uint64, groupNumbers types.GroupNumbers,
+	blockNumber uint32) (*operatorSnapshot, error) {
+	key := newSnapshotKey(chainID, blockNumber, groupNumbers)
+	if snapshot, ok := ra.snapshots.get(key); ok {
+		ra.metrics.SnapshotCacheHits.With("lookup", snapshotLookupOperatorState).Add(1)
+		return snapshot, nil
+	}
+	ra.metrics.SnapshotCacheMisses.With("lookup", snapshotLookupOperatorState).Add(1)
+
+	start := time.Now()
+	operatorsDvsStateDict, err := ra.dvsReader.GetOperatorsDVSStateAtBlock(chainID, groupNumbers, blockNumber)
+	ra.observeReaderCall("get_operators_dvs_state_at_block", start)
+	if err != nil {
+		ra.logger.Error("Failed to get operators DVS state", "block", blockNumber, "error", err)
+		return nil, err
+	}
+
+	start = time.Now()
+	groupsDvsStateDict, err := ra.dvsReader.GetGroupsDVSStateAtBlock(chainID, groupNumbers, blockNumber)
+	ra.observeReaderCall("get_groups_dvs_state_at_block", start)
+	if err != nil {
+		ra.logger.Error("Failed to get groups DVS state", "block", blockNumber, "error", err)
+		return nil, err
+	}
+
+	start = time.Now()
+	operatorStateInfo, err := ra.dvsReader.GetOperatorState(chainID, groupNumbers, blockNumber)
+	ra.observeReaderCall("get_operator_state", start)
+	if err != nil {
+		ra.logger.Error("Failed to get operator state", "error", err)
+		return nil, fmt.Errorf("failed to get operator state: %v", err)
+	}
+
+	snapshot := newOperatorSnapshot(operatorsDvsStateDict, groupsDvsStateDict, operatorStateInfo)
+	ra.metrics.SnapshotCacheSize.Set(float64(ra.snapshots.add(key, snapshot)))
+	return snapshot, nil
+}
+
+// operatorInfo returns the info of an operator registered in the snapshot
+// of the task, reading it from the chain the first time it is looked up
+func (ra *AggregatorRPCServer) operatorInfo(task *Task, operatorID types.OperatorID) (types.OperatorInfo, error) {
+	if info, ok := task.snapshot.operatorInfo(operatorID); ok {
+		ra.metrics.SnapshotCacheHits.With("lookup", snapshotLookupOperatorInfo).Add(1)
+		return info, nil
+	}
+	ra.metrics.SnapshotCacheMisses.With("lookup", snapshotLookupOperatorInfo).Add(1)
+
+	start := time.Now()
+	info, err := ra.dvsReader.GetOperatorInfoByID(operatorID)
+	ra.observeReaderCall("get_operator_info_by_id", start)
+	if err != nil {
+		return types.OperatorInfo{}, err
+	}
+	task.snapshot.setOperatorInfo(operatorID, info)
+	return info, nil
+}
@@ -3,17 +3,22 @@ package rpc
 import (
 	"math/big"
 	"net"
+	"net/http"
 	"net/rpc"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"google.golang.org/grpc"
 
 	interactorcfg "github.com/0xPellNetwork/pelldvs-interactor/config"
 	"github.com/0xPellNetwork/pelldvs-interactor/interactor/reader"
 	"github.com/0xPellNetwork/pelldvs-interactor/types"
 	"github.com/0xPellNetwork/pelldvs-libs/log"
+	aggstore "github.com/0xPellNetwork/pelldvs/aggregator/store"
 	aggtypes "github.com/0xPellNetwork/pelldvs/aggregator/types"
+	avsitypes "github.com/0xPellNetwork/pelldvs/avsi/types"
 	"github.com/0xPellNetwork/pelldvs/libs/service"
 )
 
@@ -22,19 +27,31 @@ import (
 // state information needed for the aggregation process including operator
 // information, group mappings, and threshold requirements.
 type Task struct {
+	mtx                   sync.Mutex
 	operatorResponses     map[types.OperatorID]aggtypes.ResponseWithSignature
-	responsesChan         chan aggtypes.ResponseWithSignature
-	done                  chan aggtypes.ValidatedResponse
+	done                  chan struct{} // closed once result is set
+	result                *aggtypes.ValidatedResponse
+	signers               map[types.OperatorID]bool // operators included in result, set with it
+	finalized             bool
 	timer                 *time.Timer
+	createdAt             time.Time
+	deadline              time.Time
 	taskID                string
+	request               avsitypes.DVSRequest
 	blockNumber           uint32
 	chainConfig           *interactorcfg.DVSConfig
 	digestToOperators     map[ResultDigest][]types.OperatorID
+	snapshot              *operatorSnapshot // shared with the tasks of the same chain, block and groups
 	operatorStateInfo     *reader.OperatorStateInfo
 	operatorsDvsStateDict map[types.OperatorID]types.OperatorDVSState
 	groupOperatorMap      map[types.GroupNumber]types.GroupDVSState
 	groupNumbers          types.GroupNumbers
 	thresholdPercentages  types.GroupThresholdPercentages
+
+	thresholdPercentagesMap map[types.GroupNumber]types.GroupThresholdPercentage
+	totalStakePerGroup      map[types.GroupNumber]*big.Int
+	signedStakePerDigest    map[ResultDigest]map[types.GroupNumber]*big.Int
+	quorumReached           bool
 }
 
 // AggregatorRPCServer implements the Aggregator interface over RPC.
@@ -44,17 +61,46 @@ type Task struct {
 type AggregatorRPCServer struct {
 	service.BaseService
 	tasks                   map[string]*Task
+	results                 map[string]*finalizedResult
+	resultCacheTTL          time.Duration
 	tasksMutex              sync.RWMutex
 	tasksLocks              map[string]*sync.Mutex
 	operatorResponseTimeout time.Duration
 	server                  *rpc.Server
 	listener                net.Listener
+	rpcCalls                callTracker // net/rpc calls read but not answered yet
 	rpcAddress              string
+	grpcServer              *grpc.Server
+	grpcAddress             string
 	chainConfigs            map[uint64]*interactorcfg.DVSConfig
 	dvsReader               reader.DVSReader
+	metrics                 *Metrics
+	prometheusSrv           *http.Server
+	prometheusAddr          string
+	store                   *aggstore.TaskStore
+	resultRetention         time.Duration
+	allowUnauthenticated    bool
+	limits                  admissionLimits
+	activeTasks             int                 // tasks created or being created, guarded by tasksMutex
+	activeTasksPerChain     map[int64]int       // guarded by tasksMutex
+	pendingRestores         map[string]struct{} // stored tasks not restored yet, guarded by tasksMutex
+	shutdownDrainTimeout    time.Duration
+	shuttingDown            atomic.Bool // set on stop, new tasks are refused
+	snapshots               *snapshotCache
 	logger                  log.Logger
 }
 
+// finalizedResult is the result of a finalized task, cached to answer the
+// operators whose responses arrive after the task was finalized
+type finalizedResult struct {
+	result  *aggtypes.ValidatedResponse
+	signers map[types.OperatorID]bool
+	// task is the finalized task, whose responses late submissions are
+	// checked against for equivocation. It is unknown for loaded results.
+	task      *Task
+	expiresAt time.Time
+}
+
 // ResultDigest represents a 32-byte hash of a response result.
 // It serves as a unique identifier for operator response consensus
 // and is used to group matching responses during aggregation.
@@ -0,0 +1,105 @@
+package rpc
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/0xPellNetwork/pelldvs-interactor/types"
+	"github.com/0xPellNetwork/pelldvs-libs/crypto/bls"
+	aggtypes "github.com/0xPellNetwork/pelldvs/aggregator/types"
+)
+
+// verifyAggregate runs the check of the on-chain BLS signature checker on an
+// aggregated result. The aggregate public key is rebuilt the way the contract
+// does it, as the sum of the group APKs minus every non-signer once per
+// requested group it belongs to. It must match the signers' G2 aggregate
+// public key, under which the aggregated signature must verify the digest.
+func verifyAggregate(task *Task, result *aggtypes.ValidatedResponse, digest ResultDigest) error {
+	if result.SignersApkG2 == nil || result.SignersAggSigG1 == nil || result.SignersAggSigG1.G1Point == nil {
+		return errors.New("aggregated signature or public key is missing")
+	}
+
+	// number of requested groups each registered operator belongs to, by G1 public key
+	groupCounts := make(map[string]int, len(task.operatorsDvsStateDict))
+	for _, state := range task.operatorsDvsStateDict {
+		if state.OperatorInfo.Pubkeys.G1Pubkey == nil {
+			continue
+		}
+		count := 0
+		for _, groupNumber := range task.groupNumbers {
+			if _, ok := state.StakePerGroup[groupNumber]; ok {
+				count++
+			}
+		}
+		groupCounts[string(state.OperatorInfo.Pubkeys.G1Pubkey.Serialize())] = count
+	}
+
+	apk := bls.NewZeroG1Point()
+	for i, groupApk := range result.GroupApksG1 {
+		if groupApk == nil {
+			return fmt.Errorf("APK of group %d is missing", task.groupNumbers[i])
+		}
+		apk.Add(groupApk)
+	}
+	for _, pubkey := range result.NonSignersPubkeysG1 {
+		count, ok := groupCounts[string(pubkey.Serialize())]
+		if !ok {
+			return fmt.Errorf("non-signer %x is not registered in groups %v", pubkey.Serialize(), task.groupNumbers)
+		}
+		for i := 0; i < count; i++ {
+			apk.Sub(pubkey)
+		}
+	}
+
+	equivalent, err := apk.VerifyEquivalence(result.SignersApkG2)
+	if err != nil {
+		return fmt.Errorf("failed to check the aggregate public keys: %v", err)
+	}
+	if !equivalent {
+		return errors.New("signers G2 public key does not match the group APKs minus the non-signers")
+	}
+
+	valid, err := result.SignersAggSigG1.Verify(result.SignersApkG2, digest)
+	if err != nil {
+		return fmt.Errorf("failed to verify the aggregated signature: %v", err)
+	}
+	if !valid {
+		return fmt.Errorf("aggregated signature does not match digest %x", digest)
+	}
+	return nil
+}
+
+// findFaultySigners returns the signers of the digest whose signature does not
+// verify under their G2 public key, or whose G1 and G2 public keys differ
+func findFaultySigners(task *Task, digest ResultDigest) []types.OperatorID {
+	var faulty []types.OperatorID
+	for _, operatorID := range task.digestToOperators[digest] {
+		if err := checkSigner(task, operatorID, digest); err != nil {
+			faulty = append(faulty, operatorID)
+		}
+	}
+	return faulty
+}
+
+// checkSigner checks the keys and the signature of a single signer
+func checkSigner(task *Task, operatorID types.OperatorID, digest ResultDigest) error {
+	pubkeys := task.operatorsDvsStateDict[operatorID].OperatorInfo.Pubkeys
+	if pubkeys.G1Pubkey == nil || pubkeys.G2Pubkey == nil {
+		return errors.New("public key is missing")
+	}
+
+	equivalent, err := pubkeys.G1Pubkey.VerifyEquivalence(pubkeys.G2Pubkey)
+	if err != nil || !equivalent {
+		return errors.New("G1 and G2 public keys do not match")
+	}
+
+	signature := task.operatorResponses[operatorID].Signature
+	if signature == nil || signature.G1Point == nil {
+		return errors.New("signature is missing")
+	}
+	valid, err := signature.Verify(pubkeys.G2Pubkey, digest)
+	if err != nil || !valid {
+		return errors.New("signature does not verify")
+	}
+	return nil
+}
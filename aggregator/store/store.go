@@ -0,0 +1,404 @@
+// Package store persists the aggregation tasks of the aggregator so that
+// in-flight tasks survive a restart and finalized results can be served
+// for a while after the task is done. It also keeps the evidence of the
+// operators that signed conflicting digests, which is never pruned.
+package store
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/gogoproto/proto"
+
+	agggrpc "github.com/0xPellNetwork/pelldvs/aggregator/grpc"
+	aggtypes "github.com/0xPellNetwork/pelldvs/aggregator/types"
+	avsitypes "github.com/0xPellNetwork/pelldvs/avsi/types"
+)
+
+const (
+	taskKeyPrefix     = "task/"
+	responseKeyPrefix = "response/"
+	resultKeyPrefix   = "result/"
+	evidenceKeyPrefix = "evidence/"
+)
+
+// ErrStoreClosed is returned by the calls made to a TaskStore once it is closed
+var ErrStoreClosed = errors.New("task store is closed")
+
+// StoredTask is an in-flight aggregation task loaded back from the store
+// together with the operator responses it had collected
+type StoredTask struct {
+	TaskID    string
+	Request   avsitypes.DVSRequest
+	Deadline  time.Time
+	Responses []*aggtypes.ResponseWithSignature
+}
+
+// TaskStore keeps in-flight aggregation tasks, the operator responses they
+// have collected and finalized results in a key-value database. Calls made
+// once the store is closed fail with ErrStoreClosed, and closing the store
+// waits for the calls in progress.
+type TaskStore struct {
+	db dbm.DB
+
+	mtx    sync.RWMutex
+	closed bool
+}
+
+// NewTaskStore creates a TaskStore backed by the given database
+func NewTaskStore(db dbm.DB) *TaskStore {
+	return &TaskStore{db: db}
+}
+
+// SaveTask persists a newly created task with the time it is due to be finalized
+func (s *TaskStore) SaveTask(taskID string, request avsitypes.DVSRequest, deadline time.Time) error {
+	unlock, err := s.acquire()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	record := &agggrpc.TaskRecord{
+		Request:  &request,
+		Deadline: deadline.UnixNano(),
+	}
+	rawBytes, err := proto.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("failed to marshal task: %v", err)
+	}
+	return s.db.Set(taskKey(taskID), rawBytes)
+}
+
+// SaveResponse persists an operator response collected by a task
+func (s *TaskStore) SaveResponse(taskID string, response *aggtypes.ResponseWithSignature) error {
+	unlock, err := s.acquire()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	rawBytes, err := proto.Marshal(agggrpc.ResponseWithSignatureToProto(response))
+	if err != nil {
+		return fmt.Errorf("failed to marshal response: %v", err)
+	}
+	return s.db.Set(responseKey(taskID, response.OperatorID), rawBytes)
+}
+
+// SaveResult persists the result of a finalized task and removes the task
+// and its responses in the same batch
+func (s *TaskStore) SaveResult(taskID string, result *aggtypes.ValidatedResponse, finalizedAt time.Time) error {
+	unlock, err := s.acquire()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	record := &agggrpc.ResultRecord{
+		Result:      agggrpc.ValidatedResponseToProto(result),
+		FinalizedAt: finalizedAt.UnixNano(),
+	}
+	rawBytes, err := proto.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("failed to marshal result: %v", err)
+	}
+
+	batch := s.db.NewBatch()
+	defer batch.Close()
+
+	if err := s.deleteTask(batch, taskID); err != nil {
+		return err
+	}
+	if err := batch.Set(resultKey(taskID), rawBytes); err != nil {
+		return err
+	}
+	return batch.WriteSync()
+}
+
+// DeleteTask removes a task and its responses without saving a result
+func (s *TaskStore) DeleteTask(taskID string) error {
+	unlock, err := s.acquire()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	batch := s.db.NewBatch()
+	defer batch.Close()
+
+	if err := s.deleteTask(batch, taskID); err != nil {
+		return err
+	}
+	return batch.WriteSync()
+}
+
+func (s *TaskStore) deleteTask(batch dbm.Batch, taskID string) error {
+	if err := batch.Delete(taskKey(taskID)); err != nil {
+		return err
+	}
+
+	it, err := dbm.IteratePrefix(s.db, responsePrefix(taskID))
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		if err := batch.Delete(it.Key()); err != nil {
+			return err
+		}
+	}
+	return it.Error()
+}
+
+// LoadTasks returns every persisted in-flight task with its responses
+func (s *TaskStore) LoadTasks() ([]*StoredTask, error) {
+	unlock, err := s.acquire()
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	it, err := dbm.IteratePrefix(s.db, []byte(taskKeyPrefix))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	var tasks []*StoredTask
+	for ; it.Valid(); it.Next() {
+		record := new(agggrpc.TaskRecord)
+		if err := proto.Unmarshal(it.Value(), record); err != nil {
+			return nil, fmt.Errorf("error reading task %s: %v", it.Key(), err)
+		}
+		if record.Request == nil {
+			return nil, fmt.Errorf("task %s has no request", it.Key())
+		}
+
+		task := &StoredTask{
+			TaskID:   string(it.Key()[len(taskKeyPrefix):]),
+			Request:  *record.Request,
+			Deadline: time.Unix(0, record.Deadline),
+		}
+		task.Responses, err = s.loadResponses(task.TaskID)
+		if err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, task)
+	}
+	if err := it.Error(); err != nil {
+		return nil, err
+	}
+	return tasks, nil
+}
+
+// LoadResponses returns the persisted responses of a task
+func (s *TaskStore) LoadResponses(taskID string) ([]*aggtypes.ResponseWithSignature, error) {
+	unlock, err := s.acquire()
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	return s.loadResponses(taskID)
+}
+
+func (s *TaskStore) loadResponses(taskID string) ([]*aggtypes.ResponseWithSignature, error) {
+	it, err := dbm.IteratePrefix(s.db, responsePrefix(taskID))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	var responses []*aggtypes.ResponseWithSignature
+	for ; it.Valid(); it.Next() {
+		pb := new(agggrpc.ResponseWithSignature)
+		if err := proto.Unmarshal(it.Value(), pb); err != nil {
+			return nil, fmt.Errorf("error reading response %s: %v", it.Key(), err)
+		}
+		response, err := agggrpc.ResponseWithSignatureFromProto(pb)
+		if err != nil {
+			return nil, fmt.Errorf("error reading response %s: %v", it.Key(), err)
+		}
+		responses = append(responses, response)
+	}
+	if err := it.Error(); err != nil {
+		return nil, err
+	}
+	return responses, nil
+}
+
+// LoadResult returns the persisted result of a finalized task, or nil if
+// there is none
+func (s *TaskStore) LoadResult(taskID string) (*aggtypes.ValidatedResponse, error) {
+	unlock, err := s.acquire()
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	rawBytes, err := s.db.Get(resultKey(taskID))
+	if err != nil {
+		return nil, err
+	}
+	if rawBytes == nil {
+		return nil, nil
+	}
+
+	record := new(agggrpc.ResultRecord)
+	if err := proto.Unmarshal(rawBytes, record); err != nil {
+		return nil, fmt.Errorf("error reading result %s: %v", taskID, err)
+	}
+	return agggrpc.ValidatedResponseFromProto(record.Result)
+}
+
+// PruneResults deletes the results finalized before the given time and
+// returns how many were deleted
+func (s *TaskStore) PruneResults(before time.Time) (int, error) {
+	unlock, err := s.acquire()
+	if err != nil {
+		return 0, err
+	}
+	defer unlock()
+
+	keys, err := s.resultKeysBefore(before)
+	if err != nil || len(keys) == 0 {
+		return 0, err
+	}
+
+	batch := s.db.NewBatch()
+	defer batch.Close()
+
+	for _, key := range keys {
+		if err := batch.Delete(key); err != nil {
+			return 0, err
+		}
+	}
+	return len(keys), batch.Write()
+}
+
+func (s *TaskStore) resultKeysBefore(before time.Time) ([][]byte, error) {
+	it, err := dbm.IteratePrefix(s.db, []byte(resultKeyPrefix))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	var keys [][]byte
+	for ; it.Valid(); it.Next() {
+		record := new(agggrpc.ResultRecord)
+		if err := proto.Unmarshal(it.Value(), record); err != nil {
+			return nil, fmt.Errorf("error reading result %s: %v", it.Key(), err)
+		}
+		if record.FinalizedAt < before.UnixNano() {
+			keys = append(keys, append([]byte{}, it.Key()...))
+		}
+	}
+	return keys, it.Error()
+}
+
+// SaveEvidence persists equivocation evidence. Only the first evidence of an
+// operator for a request is kept, it reports whether the evidence was new.
+func (s *TaskStore) SaveEvidence(evidence *aggtypes.Evidence) (bool, error) {
+	unlock, err := s.acquire()
+	if err != nil {
+		return false, err
+	}
+	defer unlock()
+
+	key := evidenceKey(evidence.OperatorID, evidence.RequestHash)
+	exists, err := s.db.Has(key)
+	if err != nil || exists {
+		return false, err
+	}
+
+	rawBytes, err := proto.Marshal(agggrpc.EvidenceToProto(evidence))
+	if err != nil {
+		return false, fmt.Errorf("failed to marshal evidence: %v", err)
+	}
+	return true, s.db.SetSync(key, rawBytes)
+}
+
+// ListEvidence returns the persisted evidence matching the query
+func (s *TaskStore) ListEvidence(query aggtypes.EvidenceQuery) ([]aggtypes.Evidence, error) {
+	unlock, err := s.acquire()
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	prefix := []byte(evidenceKeyPrefix)
+	if len(query.OperatorID) > 0 {
+		prefix = append(prefix, hex.EncodeToString(query.OperatorID)+"/"...)
+	}
+
+	it, err := dbm.IteratePrefix(s.db, prefix)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	var evidence []aggtypes.Evidence
+	for ; it.Valid(); it.Next() {
+		pb := new(agggrpc.Evidence)
+		if err := proto.Unmarshal(it.Value(), pb); err != nil {
+			return nil, fmt.Errorf("error reading evidence %s: %v", it.Key(), err)
+		}
+		if len(query.RequestHash) > 0 && !bytes.Equal(pb.RequestHash, query.RequestHash) {
+			continue
+		}
+		ev, err := agggrpc.EvidenceFromProto(pb)
+		if err != nil {
+			return nil, fmt.Errorf("error reading evidence %s: %v", it.Key(), err)
+		}
+		evidence = append(evidence, *ev)
+	}
+	return evidence, it.Error()
+}
+
+// Close closes the underlying database once the calls in progress return
+func (s *TaskStore) Close() error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if s.closed {
+		return nil
+	}
+	s.closed = true
+	return s.db.Close()
+}
+
+// acquire keeps the store open until the returned function is called, or
+// returns ErrStoreClosed if it is closed already
+func (s *TaskStore) acquire() (func(), error) {
+	s.mtx.RLock()
+	if s.closed {
+		s.mtx.RUnlock()
+		return nil, ErrStoreClosed
+	}
+	return s.mtx.RUnlock, nil
+}
+
+func taskKey(taskID string) []byte {
+	return []byte(taskKeyPrefix + taskID)
+}
+
+func responsePrefix(taskID string) []byte {
+	return []byte(responseKeyPrefix + taskID + "/")
+}
+
+func responseKey(taskID string, operatorID [32]byte) []byte {
+	return append(responsePrefix(taskID), hex.EncodeToString(operatorID[:])...)
+}
+
+func resultKey(taskID string) []byte {
+	return []byte(resultKeyPrefix + taskID)
+}
+
+func evidenceKey(operatorID [32]byte, requestHash []byte) []byte {
+	return []byte(evidenceKeyPrefix + hex.EncodeToString(operatorID[:]) + "/" + hex.EncodeToString(requestHash))
+}
@@ -0,0 +1,150 @@
+package store
+
+import (
+	"testing"
+	"time"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPellNetwork/pelldvs-libs/crypto/bls"
+	aggtypes "github.com/0xPellNetwork/pelldvs/aggregator/types"
+	avsitypes "github.com/0xPellNetwork/pelldvs/avsi/types"
+)
+
+func TestTaskStoreSaveAndLoad(t *testing.T) {
+	store := NewTaskStore(dbm.NewMemDB())
+	keyPair, err := bls.GenRandomBlsKeys()
+	require.NoError(t, err)
+
+	request := avsitypes.DVSRequest{Data: []byte("request"), Height: 10, ChainId: 1}
+	deadline := time.Unix(0, time.Now().UnixNano())
+	require.NoError(t, store.SaveTask("task", request, deadline))
+
+	response := &aggtypes.ResponseWithSignature{
+		Data:        []byte("response"),
+		Digest:      [32]byte{1},
+		Signature:   keyPair.SignMessage([32]byte{1}),
+		OperatorID:  [32]byte{2},
+		RequestData: request,
+	}
+	require.NoError(t, store.SaveResponse("task", response))
+	// responses of another task are not loaded with this one
+	require.NoError(t, store.SaveResponse("task2", response))
+
+	tasks, err := store.LoadTasks()
+	require.NoError(t, err)
+	require.Len(t, tasks, 1)
+	require.Equal(t, "task", tasks[0].TaskID)
+	require.Equal(t, request.Hash(), tasks[0].Request.Hash())
+	require.True(t, deadline.Equal(tasks[0].Deadline))
+	require.Len(t, tasks[0].Responses, 1)
+	require.Equal(t, response.OperatorID, tasks[0].Responses[0].OperatorID)
+	require.Equal(t, response.Signature.Serialize(), tasks[0].Responses[0].Signature.Serialize())
+
+	result := &aggtypes.ValidatedResponse{Data: []byte("result"), Hash: []byte("task")}
+	require.NoError(t, store.SaveResult("task", result, time.Now()))
+
+	tasks, err = store.LoadTasks()
+	require.NoError(t, err)
+	require.Empty(t, tasks)
+
+	loaded, err := store.LoadResult("task")
+	require.NoError(t, err)
+	require.Equal(t, result.Data, loaded.Data)
+
+	loaded, err = store.LoadResult("unknown")
+	require.NoError(t, err)
+	require.Nil(t, loaded)
+}
+
+func TestTaskStorePruneResults(t *testing.T) {
+	store := NewTaskStore(dbm.NewMemDB())
+	now := time.Now()
+
+	result := &aggtypes.ValidatedResponse{Data: []byte("result")}
+	require.NoError(t, store.SaveResult("old", result, now.Add(-2*time.Hour)))
+	require.NoError(t, store.SaveResult("new", result, now))
+
+	pruned, err := store.PruneResults(now.Add(-time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, 1, pruned)
+
+	loaded, err := store.LoadResult("old")
+	require.NoError(t, err)
+	require.Nil(t, loaded)
+
+	loaded, err = store.LoadResult("new")
+	require.NoError(t, err)
+	require.NotNil(t, loaded)
+}
+
+func TestTaskStoreEvidence(t *testing.T) {
+	store := NewTaskStore(dbm.NewMemDB())
+	keyPair, err := bls.GenRandomBlsKeys()
+	require.NoError(t, err)
+
+	request := avsitypes.DVSRequest{Data: []byte("request"), Height: 10, ChainId: 1}
+	response := func(operatorID byte, digest byte) aggtypes.ResponseWithSignature {
+		return aggtypes.ResponseWithSignature{
+			Data:        []byte{digest},
+			Digest:      [32]byte{digest},
+			Signature:   keyPair.SignMessage([32]byte{digest}),
+			OperatorID:  [32]byte{operatorID},
+			RequestData: request,
+		}
+	}
+	evidence := &aggtypes.Evidence{
+		RequestHash: request.Hash(),
+		OperatorID:  [32]byte{1},
+		First:       response(1, 1),
+		Second:      response(1, 2),
+		DetectedAt:  time.Unix(0, time.Now().UnixNano()),
+	}
+
+	saved, err := store.SaveEvidence(evidence)
+	require.NoError(t, err)
+	require.True(t, saved)
+	// a second conflict of the same operator for the request is not recorded
+	saved, err = store.SaveEvidence(evidence)
+	require.NoError(t, err)
+	require.False(t, saved)
+
+	other := *evidence
+	other.OperatorID = [32]byte{2}
+	other.First, other.Second = response(2, 1), response(2, 3)
+	saved, err = store.SaveEvidence(&other)
+	require.NoError(t, err)
+	require.True(t, saved)
+
+	all, err := store.ListEvidence(aggtypes.EvidenceQuery{})
+	require.NoError(t, err)
+	require.Len(t, all, 2)
+
+	byOperator, err := store.ListEvidence(aggtypes.EvidenceQuery{OperatorID: evidence.OperatorID[:]})
+	require.NoError(t, err)
+	require.Len(t, byOperator, 1)
+	require.Equal(t, evidence.First.Digest, byOperator[0].First.Digest)
+	require.Equal(t, evidence.Second.Digest, byOperator[0].Second.Digest)
+	require.Equal(t, evidence.Second.Signature.Serialize(), byOperator[0].Second.Signature.Serialize())
+	require.True(t, evidence.DetectedAt.Equal(byOperator[0].DetectedAt))
+
+	byRequest, err := store.ListEvidence(aggtypes.EvidenceQuery{RequestHash: []byte("unknown")})
+	require.NoError(t, err)
+	require.Empty(t, byRequest)
+}
+
+func TestTaskStoreClose(t *testing.T) {
+	store := NewTaskStore(dbm.NewMemDB())
+	request := avsitypes.DVSRequest{Data: []byte("request"), Height: 10, ChainId: 1}
+	require.NoError(t, store.SaveTask("task", request, time.Now()))
+	require.NoError(t, store.Close())
+
+	// writes of tasks finalized after shutdown do not reach the database
+	result := &aggtypes.ValidatedResponse{Data: []byte("result")}
+	require.ErrorIs(t, store.SaveResult("task", result, time.Now()), ErrStoreClosed)
+	require.ErrorIs(t, store.DeleteTask("task"), ErrStoreClosed)
+	_, err := store.LoadResult("task")
+	require.ErrorIs(t, err, ErrStoreClosed)
+	require.NoError(t, store.Close())
+}
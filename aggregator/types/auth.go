@@ -0,0 +1,68 @@
+package types
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/0xPellNetwork/pelldvs/types"
+)
+
+var (
+	// ErrMissingOperatorSignature is returned when a submission carries no
+	// ECDSA signature of its operator
+	ErrMissingOperatorSignature = errors.New("missing operator signature")
+	// ErrOperatorMismatch is returned when a submission is signed by an
+	// address other than the one of the operator it claims to come from
+	ErrOperatorMismatch = errors.New("operator signature does not match operator ID")
+)
+
+// SubmissionHash returns the hash an operator signs with its ECDSA key to
+// authenticate the submission. It covers the request, the response, the BLS
+// signature and the operator ID, so none of them can be swapped afterwards.
+func (r *ResponseWithSignature) SubmissionHash() [32]byte {
+	var blsSignature []byte
+	if r.Signature != nil {
+		blsSignature = r.Signature.Serialize()
+	}
+	return crypto.Keccak256Hash(
+		r.RequestData.Hash(),
+		crypto.Keccak256(r.Data),
+		r.Digest[:],
+		blsSignature,
+		r.OperatorID[:],
+	)
+}
+
+// SignOperator signs the submission with the ECDSA key of the operator
+func (r *ResponseWithSignature) SignOperator(key *ecdsa.PrivateKey) error {
+	hash := r.SubmissionHash()
+	signature, err := crypto.Sign(hash[:], key)
+	if err != nil {
+		return fmt.Errorf("failed to sign submission: %v", err)
+	}
+	r.OperatorSignature = signature
+	return nil
+}
+
+// VerifyOperator checks that the submission is signed by the ECDSA key of
+// the address its operator ID is derived from
+func (r *ResponseWithSignature) VerifyOperator() error {
+	if len(r.OperatorSignature) == 0 {
+		return ErrMissingOperatorSignature
+	}
+
+	hash := r.SubmissionHash()
+	pubkey, err := crypto.SigToPub(hash[:], r.OperatorSignature)
+	if err != nil {
+		return fmt.Errorf("invalid operator signature: %v", err)
+	}
+
+	signer := crypto.PubkeyToAddress(*pubkey)
+	if types.OperatorIDFromAddress(signer) != r.OperatorID {
+		return fmt.Errorf("%w: signed by %s", ErrOperatorMismatch, signer)
+	}
+	return nil
+}
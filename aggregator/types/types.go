@@ -1,6 +1,12 @@
 package types
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
 	"github.com/0xPellNetwork/pelldvs-libs/crypto/bls"
 	avsitypes "github.com/0xPellNetwork/pelldvs/avsi/types"
 	rpctypes "github.com/0xPellNetwork/pelldvs/rpc/jsonrpc/types"
@@ -11,25 +17,42 @@ import (
 // collecting signatures from operators and aggregating them into a single
 // validated response.
 type Aggregator interface {
-	// CollectResponseSignature collects the response signature from the operator
-	CollectResponseSignature(response *ResponseWithSignature, result chan<- ValidatedResponse) error
+	// CollectResponseSignature collects the response signature from the
+	// operator. It stops waiting for the aggregator when the context is done.
+	CollectResponseSignature(ctx context.Context, response *ResponseWithSignature, result chan<- ValidatedResponse) error
+}
+
+// BatchAggregator is implemented by the aggregators accepting the responses
+// of an operator to several requests in a single call
+type BatchAggregator interface {
+	Aggregator
+	// CollectResponseSignatures collects the response signatures of the operator
+	// to several requests. The validated responses are sent in the order of the
+	// responses, each carrying its own error when its request failed.
+	CollectResponseSignatures(ctx context.Context, responses []*ResponseWithSignature,
+		results chan<- []ValidatedResponse) error
 }
 
 // ResponseWithSignature encapsulates a response with its signature from an operator.
 // It contains the original data, a cryptographic digest, the BLS signature,
 // operator identification, and the original request that triggered this response.
+// OperatorSignature is the ECDSA signature of the operator over the submission,
+// see SubmissionHash.
 type ResponseWithSignature struct {
-	Data        []byte
-	Digest      [32]byte
-	Signature   *bls.Signature
-	OperatorID  [32]byte
-	RequestData avsitypes.DVSRequest
+	Data              []byte
+	Digest            [32]byte
+	Signature         *bls.Signature
+	OperatorID        [32]byte
+	RequestData       avsitypes.DVSRequest
+	OperatorSignature []byte
 }
 
 // ValidatedResponse represents the result of a successful signature aggregation.
 // It contains the aggregated data, any errors encountered, cryptographic hash,
 // public keys of non-signers, group aggregate public keys, signer information,
 // and various bitmap indices used for on-chain verification.
+// NotIncluded is set on a result returned to an operator whose response
+// arrived after the task was finalized and is not part of the aggregate.
 type ValidatedResponse struct {
 	Data                        []byte
 	Err                         *rpctypes.RPCError
@@ -42,4 +65,84 @@ type ValidatedResponse struct {
 	GroupApkIndices             []uint32
 	TotalStakeIndices           []uint32
 	NonSignerStakeIndices       [][]uint32
+	NotIncluded                 bool
+}
+
+// TaskState describes how far the aggregation task of a request has progressed
+type TaskState int
+
+const (
+	// TaskStateUnknown means the aggregator has no task for the request
+	TaskStateUnknown TaskState = iota
+	// TaskStatePending means the task is still collecting operator responses
+	TaskStatePending
+	// TaskStateFinalized means the task has been aggregated and has a result
+	TaskStateFinalized
+)
+
+// TaskStatus reports the state of the aggregation task of a request,
+// the number of operator responses it has collected and, once finalized,
+// its aggregated result.
+type TaskStatus struct {
+	State          TaskState
+	ResponsesCount int
+	Result         *ValidatedResponse
+}
+
+// ErrTaskResultNotFound is returned when the aggregator holds no finalized
+// result for a request
+var ErrTaskResultNotFound = errors.New("task result not found")
+
+// AggregationError is returned by aggregator clients when the aggregator
+// was reached but answered with an error instead of an aggregated result
+type AggregationError struct {
+	Err *rpctypes.RPCError
+}
+
+func (e *AggregationError) Error() string {
+	return fmt.Sprintf("aggregator returned error: %v", e.Err)
+}
+
+// TaskInfo describes an in-flight aggregation task: the request it serves,
+// the operator set it was created for and the responses collected so far.
+// Operators are identified by their operator ID.
+type TaskInfo struct {
+	RequestHash          []byte
+	Request              avsitypes.DVSRequest
+	ChainID              int64
+	BlockNumber          uint32
+	GroupNumbers         []uint32
+	ThresholdPercentages []uint32
+	Deadline             time.Time
+	TotalStakePerGroup   map[uint32]*big.Int
+	Signers              [][32]byte
+	Digests              []DigestInfo
+	MissingOperators     [][32]byte
+}
+
+// DigestInfo lists the operators of a task that signed one response digest
+// and the stake they hold in each group of the task.
+type DigestInfo struct {
+	Digest        [32]byte
+	Signers       [][32]byte
+	StakePerGroup map[uint32]*big.Int
+}
+
+// Evidence proves that an operator signed two different digests for the same
+// request. Both responses carry the BLS signature of the operator over their
+// digest and its ECDSA signature over the submission, so the evidence can be
+// checked by anyone holding the operator keys registered on-chain.
+type Evidence struct {
+	RequestHash []byte
+	OperatorID  [32]byte
+	First       ResponseWithSignature
+	Second      ResponseWithSignature
+	DetectedAt  time.Time
+}
+
+// EvidenceQuery selects the evidence recorded by the aggregator. Empty fields
+// match any operator or request.
+type EvidenceQuery struct {
+	OperatorID  []byte
+	RequestHash []byte
 }
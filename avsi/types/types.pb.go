@@ -10,18 +10,22 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	_ "github.com/cosmos/gogoproto/types"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -29,6 +33,59 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// DVSRequestStatus is the stage of the lifecycle a DVS request reached
+type DVSRequestStatus int32
+
+const (
+	DVS_REQUEST_STATUS_UNKNOWN       DVSRequestStatus = 0
+	DVS_REQUEST_STATUS_RECEIVED      DVSRequestStatus = 1
+	DVS_REQUEST_STATUS_APP_PROCESSED DVSRequestStatus = 2
+	DVS_REQUEST_STATUS_SIGNED        DVSRequestStatus = 3
+	DVS_REQUEST_STATUS_SUBMITTED     DVSRequestStatus = 4
+	DVS_REQUEST_STATUS_AGGREGATED    DVSRequestStatus = 5
+	DVS_REQUEST_STATUS_FINALIZED     DVSRequestStatus = 6
+	DVS_REQUEST_STATUS_FAILED        DVSRequestStatus = 7
+	// the deadline of the request passed or its caller went away
+	DVS_REQUEST_STATUS_CANCELLED DVSRequestStatus = 8
+	// the operator of the node was not a member of the request groups at its
+	// height, so it did not sign the response
+	DVS_REQUEST_STATUS_NOT_MEMBER DVSRequestStatus = 9
+)
+
+var DVSRequestStatus_name = map[int32]string{
+	0: "DVS_REQUEST_STATUS_UNKNOWN",
+	1: "DVS_REQUEST_STATUS_RECEIVED",
+	2: "DVS_REQUEST_STATUS_APP_PROCESSED",
+	3: "DVS_REQUEST_STATUS_SIGNED",
+	4: "DVS_REQUEST_STATUS_SUBMITTED",
+	5: "DVS_REQUEST_STATUS_AGGREGATED",
+	6: "DVS_REQUEST_STATUS_FINALIZED",
+	7: "DVS_REQUEST_STATUS_FAILED",
+	8: "DVS_REQUEST_STATUS_CANCELLED",
+	9: "DVS_REQUEST_STATUS_NOT_MEMBER",
+}
+
+var DVSRequestStatus_value = map[string]int32{
+	"DVS_REQUEST_STATUS_UNKNOWN":       0,
+	"DVS_REQUEST_STATUS_RECEIVED":      1,
+	"DVS_REQUEST_STATUS_APP_PROCESSED": 2,
+	"DVS_REQUEST_STATUS_SIGNED":        3,
+	"DVS_REQUEST_STATUS_SUBMITTED":     4,
+	"DVS_REQUEST_STATUS_AGGREGATED":    5,
+	"DVS_REQUEST_STATUS_FINALIZED":     6,
+	"DVS_REQUEST_STATUS_FAILED":        7,
+	"DVS_REQUEST_STATUS_CANCELLED":     8,
+	"DVS_REQUEST_STATUS_NOT_MEMBER":    9,
+}
+
+func (x DVSRequestStatus) String() string {
+	return proto.EnumName(DVSRequestStatus_name, int32(x))
+}
+
+func (DVSRequestStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_fd5084df8e613950, []int{0}
+}
+
 // ----------------------------------------
 // Request types
 type Request struct {
@@ -1105,10 +1162,15 @@ func (m *NonSignerStakeIndice) GetNonSignerStakeIndice() []uint32 {
 // --------------------
 // dvs request result idx
 type DVSRequestResult struct {
-	DvsRequest                 *DVSRequest                 `protobuf:"bytes,1,opt,name=dvs_request,json=dvsRequest,proto3" json:"dvs_request,omitempty"`
-	ResponseProcessDvsRequest  *ResponseProcessDVSRequest  `protobuf:"bytes,2,opt,name=response_process_dvs_request,json=responseProcessDvsRequest,proto3" json:"response_process_dvs_request,omitempty"`
-	DvsResponse                *DVSResponse                `protobuf:"bytes,3,opt,name=dvs_response,json=dvsResponse,proto3" json:"dvs_response,omitempty"`
-	ResponseProcessDvsResponse *ResponseProcessDVSResponse `protobuf:"bytes,4,opt,name=response_process_dvs_response,json=responseProcessDvsResponse,proto3" json:"response_process_dvs_response,omitempty"`
+	DvsRequest                 *DVSRequest                  `protobuf:"bytes,1,opt,name=dvs_request,json=dvsRequest,proto3" json:"dvs_request,omitempty"`
+	ResponseProcessDvsRequest  *ResponseProcessDVSRequest   `protobuf:"bytes,2,opt,name=response_process_dvs_request,json=responseProcessDvsRequest,proto3" json:"response_process_dvs_request,omitempty"`
+	DvsResponse                *DVSResponse                 `protobuf:"bytes,3,opt,name=dvs_response,json=dvsResponse,proto3" json:"dvs_response,omitempty"`
+	ResponseProcessDvsResponse *ResponseProcessDVSResponse  `protobuf:"bytes,4,opt,name=response_process_dvs_response,json=responseProcessDvsResponse,proto3" json:"response_process_dvs_response,omitempty"`
+	Status                     DVSRequestStatus             `protobuf:"varint,5,opt,name=status,proto3,enum=pelldvs.avsi.DVSRequestStatus" json:"status,omitempty"`
+	FailureReason              string                       `protobuf:"bytes,6,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"`
+	Transitions                []DVSRequestStatusTransition `protobuf:"bytes,7,rep,name=transitions,proto3" json:"transitions"`
+	// number of times the node resumed the request after a restart
+	ResumeAttempts uint32 `protobuf:"varint,8,opt,name=resume_attempts,json=resumeAttempts,proto3" json:"resume_attempts,omitempty"`
 }
 
 func (m *DVSRequestResult) Reset()         { *m = DVSRequestResult{} }
@@ -1172,6 +1234,87 @@ func (m *DVSRequestResult) GetResponseProcessDvsResponse() *ResponseProcessDVSRe
 	return nil
 }
 
+func (m *DVSRequestResult) GetStatus() DVSRequestStatus {
+	if m != nil {
+		return m.Status
+	}
+	return DVS_REQUEST_STATUS_UNKNOWN
+}
+
+func (m *DVSRequestResult) GetFailureReason() string {
+	if m != nil {
+		return m.FailureReason
+	}
+	return ""
+}
+
+func (m *DVSRequestResult) GetTransitions() []DVSRequestStatusTransition {
+	if m != nil {
+		return m.Transitions
+	}
+	return nil
+}
+
+func (m *DVSRequestResult) GetResumeAttempts() uint32 {
+	if m != nil {
+		return m.ResumeAttempts
+	}
+	return 0
+}
+
+// DVSRequestStatusTransition records when a DVS request reached a status
+type DVSRequestStatusTransition struct {
+	Status DVSRequestStatus `protobuf:"varint,1,opt,name=status,proto3,enum=pelldvs.avsi.DVSRequestStatus" json:"status,omitempty"`
+	Time   time.Time        `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time"`
+}
+
+func (m *DVSRequestStatusTransition) Reset()         { *m = DVSRequestStatusTransition{} }
+func (m *DVSRequestStatusTransition) String() string { return proto.CompactTextString(m) }
+func (*DVSRequestStatusTransition) ProtoMessage()    {}
+func (*DVSRequestStatusTransition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fd5084df8e613950, []int{14}
+}
+func (m *DVSRequestStatusTransition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DVSRequestStatusTransition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DVSRequestStatusTransition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DVSRequestStatusTransition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DVSRequestStatusTransition.Merge(m, src)
+}
+func (m *DVSRequestStatusTransition) XXX_Size() int {
+	return m.Size()
+}
+func (m *DVSRequestStatusTransition) XXX_DiscardUnknown() {
+	xxx_messageInfo_DVSRequestStatusTransition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DVSRequestStatusTransition proto.InternalMessageInfo
+
+func (m *DVSRequestStatusTransition) GetStatus() DVSRequestStatus {
+	if m != nil {
+		return m.Status
+	}
+	return DVS_REQUEST_STATUS_UNKNOWN
+}
+
+func (m *DVSRequestStatusTransition) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
 // TODO: Adapt to our business logic
 // -----------------------------reserver----------------------------------
 type RequestFlush struct {
@@ -1181,7 +1324,7 @@ func (m *RequestFlush) Reset()         { *m = RequestFlush{} }
 func (m *RequestFlush) String() string { return proto.CompactTextString(m) }
 func (*RequestFlush) ProtoMessage()    {}
 func (*RequestFlush) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd5084df8e613950, []int{14}
+	return fileDescriptor_fd5084df8e613950, []int{15}
 }
 func (m *RequestFlush) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1217,7 +1360,7 @@ func (m *ResponseFlush) Reset()         { *m = ResponseFlush{} }
 func (m *ResponseFlush) String() string { return proto.CompactTextString(m) }
 func (*ResponseFlush) ProtoMessage()    {}
 func (*ResponseFlush) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd5084df8e613950, []int{15}
+	return fileDescriptor_fd5084df8e613950, []int{16}
 }
 func (m *ResponseFlush) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1254,7 +1397,7 @@ func (m *RequestEcho) Reset()         { *m = RequestEcho{} }
 func (m *RequestEcho) String() string { return proto.CompactTextString(m) }
 func (*RequestEcho) ProtoMessage()    {}
 func (*RequestEcho) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd5084df8e613950, []int{16}
+	return fileDescriptor_fd5084df8e613950, []int{17}
 }
 func (m *RequestEcho) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1301,7 +1444,7 @@ func (m *RequestInfo) Reset()         { *m = RequestInfo{} }
 func (m *RequestInfo) String() string { return proto.CompactTextString(m) }
 func (*RequestInfo) ProtoMessage()    {}
 func (*RequestInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd5084df8e613950, []int{17}
+	return fileDescriptor_fd5084df8e613950, []int{18}
 }
 func (m *RequestInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1369,7 +1512,7 @@ func (m *RequestQuery) Reset()         { *m = RequestQuery{} }
 func (m *RequestQuery) String() string { return proto.CompactTextString(m) }
 func (*RequestQuery) ProtoMessage()    {}
 func (*RequestQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd5084df8e613950, []int{18}
+	return fileDescriptor_fd5084df8e613950, []int{19}
 }
 func (m *RequestQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1434,7 +1577,7 @@ func (m *ResponseEcho) Reset()         { *m = ResponseEcho{} }
 func (m *ResponseEcho) String() string { return proto.CompactTextString(m) }
 func (*ResponseEcho) ProtoMessage()    {}
 func (*ResponseEcho) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd5084df8e613950, []int{19}
+	return fileDescriptor_fd5084df8e613950, []int{20}
 }
 func (m *ResponseEcho) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1482,7 +1625,7 @@ func (m *ResponseInfo) Reset()         { *m = ResponseInfo{} }
 func (m *ResponseInfo) String() string { return proto.CompactTextString(m) }
 func (*ResponseInfo) ProtoMessage()    {}
 func (*ResponseInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd5084df8e613950, []int{20}
+	return fileDescriptor_fd5084df8e613950, []int{21}
 }
 func (m *ResponseInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1563,7 +1706,7 @@ func (m *ResponseQuery) Reset()         { *m = ResponseQuery{} }
 func (m *ResponseQuery) String() string { return proto.CompactTextString(m) }
 func (*ResponseQuery) ProtoMessage()    {}
 func (*ResponseQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd5084df8e613950, []int{21}
+	return fileDescriptor_fd5084df8e613950, []int{22}
 }
 func (m *ResponseQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1664,7 +1807,7 @@ func (m *ResponseException) Reset()         { *m = ResponseException{} }
 func (m *ResponseException) String() string { return proto.CompactTextString(m) }
 func (*ResponseException) ProtoMessage()    {}
 func (*ResponseException) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fd5084df8e613950, []int{22}
+	return fileDescriptor_fd5084df8e613950, []int{23}
 }
 func (m *ResponseException) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1701,6 +1844,7 @@ func (m *ResponseException) GetError() string {
 }
 
 func init() {
+	proto.RegisterEnum("pelldvs.avsi.DVSRequestStatus", DVSRequestStatus_name, DVSRequestStatus_value)
 	proto.RegisterType((*Request)(nil), "pelldvs.avsi.Request")
 	proto.RegisterType((*Response)(nil), "pelldvs.avsi.Response")
 	proto.RegisterType((*DVSRequest)(nil), "pelldvs.avsi.DVSRequest")
@@ -1715,6 +1859,7 @@ func init() {
 	proto.RegisterType((*DVSResponse)(nil), "pelldvs.avsi.DVSResponse")
 	proto.RegisterType((*NonSignerStakeIndice)(nil), "pelldvs.avsi.NonSignerStakeIndice")
 	proto.RegisterType((*DVSRequestResult)(nil), "pelldvs.avsi.DVSRequestResult")
+	proto.RegisterType((*DVSRequestStatusTransition)(nil), "pelldvs.avsi.DVSRequestStatusTransition")
 	proto.RegisterType((*RequestFlush)(nil), "pelldvs.avsi.RequestFlush")
 	proto.RegisterType((*ResponseFlush)(nil), "pelldvs.avsi.ResponseFlush")
 	proto.RegisterType((*RequestEcho)(nil), "pelldvs.avsi.RequestEcho")
@@ -1729,108 +1874,128 @@ func init() {
 func init() { proto.RegisterFile("pelldvs/avsi/types.proto", fileDescriptor_fd5084df8e613950) }
 
 var fileDescriptor_fd5084df8e613950 = []byte{
-	// 1604 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0x4f, 0x6f, 0xdb, 0xca,
-	0x11, 0xd7, 0x5f, 0x4b, 0x1a, 0xc9, 0xff, 0xd6, 0x6a, 0x4a, 0xcb, 0x7e, 0x76, 0xca, 0x16, 0x8d,
-	0xfb, 0x5e, 0x6b, 0xc7, 0x32, 0x1e, 0x8a, 0x02, 0xfd, 0x67, 0xc3, 0xae, 0x6d, 0x14, 0x75, 0xdc,
-	0x75, 0x1b, 0xb4, 0x49, 0x01, 0x82, 0x12, 0xd7, 0x14, 0x21, 0x99, 0xdc, 0x70, 0x29, 0x25, 0xbe,
-	0xf6, 0x13, 0x04, 0x48, 0x3f, 0x4b, 0x91, 0x8f, 0x90, 0x5b, 0x73, 0x6b, 0x81, 0x02, 0x41, 0xe1,
-	0xdc, 0x7a, 0x2e, 0x7a, 0x2e, 0x76, 0x76, 0x49, 0x91, 0xb6, 0xa4, 0x38, 0xe8, 0xe9, 0xdd, 0x76,
-	0x66, 0x7f, 0x33, 0x1c, 0xce, 0x0c, 0x7f, 0x3b, 0x4b, 0x30, 0x38, 0x1b, 0x0c, 0x9c, 0x91, 0xd8,
-	0xb1, 0x47, 0xc2, 0xdb, 0x89, 0xae, 0x39, 0x13, 0xdb, 0x3c, 0x0c, 0xa2, 0x80, 0x34, 0xf4, 0xce,
-	0xb6, 0xdc, 0x69, 0xb5, 0x62, 0x5c, 0x37, 0xbc, 0xe6, 0x51, 0xb0, 0xc3, 0xc3, 0x20, 0xb8, 0x54,
-	0xc8, 0x56, 0xd3, 0x0d, 0xdc, 0x00, 0x97, 0x3b, 0x72, 0xa5, 0xb4, 0xe6, 0x9b, 0x22, 0x54, 0x28,
-	0x7b, 0x31, 0x64, 0x22, 0x22, 0x6d, 0x28, 0x5f, 0x0e, 0x86, 0xa2, 0x67, 0xe4, 0x1f, 0xe6, 0xb7,
-	0xea, 0xed, 0xd6, 0x76, 0xda, 0xf7, 0xb6, 0x46, 0xfd, 0x4a, 0x22, 0x4e, 0x72, 0x54, 0x41, 0xc9,
-	0x0e, 0x94, 0x58, 0xb7, 0x17, 0x18, 0x05, 0x34, 0x59, 0x9d, 0x68, 0x72, 0xd4, 0xed, 0x05, 0x27,
-	0x39, 0x8a, 0x40, 0x69, 0xe0, 0xf9, 0x97, 0x81, 0x51, 0x9c, 0x61, 0x70, 0xea, 0x5f, 0xa2, 0x81,
-	0x04, 0xca, 0xa8, 0x5e, 0x0c, 0x59, 0x78, 0x6d, 0x94, 0x66, 0x44, 0xf5, 0x5b, 0x89, 0x90, 0x51,
-	0x21, 0x94, 0xfc, 0x01, 0x56, 0x78, 0x18, 0x74, 0x99, 0x10, 0x96, 0x33, 0x12, 0x56, 0xa8, 0x40,
-	0x46, 0x19, 0x3d, 0x7c, 0x7f, 0xa2, 0x87, 0x73, 0x85, 0x3f, 0x7c, 0x7a, 0xa1, 0x15, 0x27, 0x39,
-	0xba, 0xac, 0x9d, 0x1c, 0x8e, 0x44, 0x9c, 0xa3, 0xe7, 0xd0, 0xcc, 0x7a, 0x16, 0x3c, 0xf0, 0x05,
-	0x33, 0xe6, 0xd0, 0xf5, 0xa3, 0x4f, 0xba, 0x56, 0xf0, 0x93, 0x1c, 0x25, 0x69, 0xdf, 0x4a, 0x7b,
-	0x50, 0x81, 0xf2, 0xc8, 0x1e, 0x0c, 0x99, 0xf9, 0xcf, 0x22, 0x54, 0x63, 0x2d, 0xf9, 0x05, 0xd4,
-	0xd8, 0xab, 0x2e, 0xe3, 0x91, 0x17, 0xf8, 0xba, 0x34, 0x9b, 0xb7, 0x9f, 0xa3, 0xa0, 0x47, 0x31,
-	0xec, 0x24, 0x47, 0xc7, 0x36, 0x64, 0x2f, 0xae, 0xab, 0x2a, 0xd2, 0xda, 0x64, 0xe3, 0x5b, 0x85,
-	0x7d, 0xac, 0x0b, 0x5b, 0x9c, 0x9c, 0x75, 0xfd, 0xc0, 0x74, 0x65, 0x1f, 0xeb, 0xca, 0x96, 0x66,
-	0x59, 0x64, 0x4a, 0xbb, 0x17, 0x97, 0xb6, 0x3c, 0x2b, 0xb0, 0x5b, 0xb5, 0xfd, 0xe3, 0xe4, 0xda,
-	0x4e, 0x29, 0x80, 0x72, 0x71, 0xcf, 0xe2, 0xfe, 0x69, 0x4a, 0x71, 0x2b, 0xe8, 0x7b, 0xeb, 0xd3,
-	0xbe, 0xef, 0x57, 0xdd, 0xb7, 0x79, 0x80, 0x71, 0x28, 0x84, 0x40, 0xc9, 0xb1, 0x23, 0x1b, 0x4b,
-	0xdb, 0xa0, 0xb8, 0x26, 0x0f, 0x60, 0xae, 0xc7, 0x3c, 0xb7, 0x17, 0x61, 0xcd, 0x8a, 0x54, 0x4b,
-	0x64, 0x15, 0xaa, 0xdd, 0x9e, 0xed, 0xf9, 0x96, 0xe7, 0x60, 0x65, 0x8a, 0xb4, 0x82, 0xf2, 0xa9,
-	0x43, 0xbe, 0x0b, 0xf3, 0x6e, 0x18, 0x0c, 0xb9, 0xe5, 0x0f, 0xaf, 0x3a, 0x2c, 0x14, 0x46, 0xe9,
-	0x61, 0x71, 0x6b, 0x9e, 0x36, 0x50, 0x79, 0xa6, 0x74, 0xe4, 0xe7, 0xb0, 0xa6, 0x40, 0x51, 0x2f,
-	0x64, 0xa2, 0x17, 0x0c, 0x1c, 0x8b, 0xb3, 0xb0, 0xcb, 0xfc, 0xc8, 0x76, 0x99, 0x30, 0xca, 0x68,
-	0xb2, 0x8a, 0x90, 0xdf, 0xc5, 0x88, 0xf3, 0x31, 0xc0, 0xfc, 0x35, 0x2c, 0x3e, 0xe1, 0x2c, 0xb4,
-	0xa3, 0x20, 0x3c, 0x1f, 0x76, 0xfa, 0xec, 0x5a, 0x90, 0x35, 0xa8, 0xb9, 0xbb, 0x16, 0x47, 0x49,
-	0xbf, 0x43, 0xd5, 0xdd, 0x55, 0xbb, 0xb8, 0xd9, 0x8e, 0x37, 0x0b, 0x7a, 0xb3, 0xad, 0x36, 0xcd,
-	0xbf, 0xe6, 0xa1, 0x1a, 0x7b, 0x23, 0x0b, 0x50, 0xf0, 0x1c, 0x6d, 0x5f, 0xf0, 0x1c, 0x62, 0x40,
-	0xc5, 0x76, 0x9c, 0x90, 0x09, 0xa1, 0xed, 0x62, 0x51, 0xe6, 0xe0, 0x8a, 0x45, 0xb6, 0x35, 0x0c,
-	0x3d, 0xcc, 0x41, 0x8d, 0x56, 0xa4, 0xfc, 0xfb, 0xd0, 0x93, 0x69, 0x13, 0x41, 0xb7, 0xcf, 0x22,
-	0x6c, 0xc2, 0x1a, 0xd5, 0x12, 0x69, 0x42, 0x59, 0x44, 0x76, 0x9f, 0x61, 0xa3, 0x15, 0xa9, 0x12,
-	0xc8, 0x8f, 0xa1, 0xa2, 0x22, 0x13, 0xba, 0x7b, 0xbe, 0xc8, 0x56, 0xf8, 0xd6, 0x9b, 0xd2, 0x18,
-	0x6d, 0xfe, 0x39, 0x0f, 0xc6, 0x34, 0xda, 0x20, 0x6d, 0xa8, 0xc4, 0x3d, 0xa9, 0x3e, 0x56, 0x23,
-	0xeb, 0x75, 0x0c, 0xa5, 0x31, 0x90, 0xb4, 0xa1, 0x1a, 0xe8, 0x87, 0x19, 0x85, 0x87, 0xc5, 0xad,
-	0x7a, 0xfb, 0xc1, 0xe4, 0x50, 0x68, 0x82, 0x33, 0xff, 0x92, 0x87, 0xd5, 0xa9, 0x04, 0x43, 0x7e,
-	0x02, 0xf5, 0xf4, 0xd7, 0xf1, 0xa9, 0x48, 0xc0, 0x19, 0x7f, 0x05, 0x3f, 0x85, 0x46, 0xa6, 0xfb,
-	0x27, 0x52, 0x7b, 0xea, 0x59, 0xb4, 0xee, 0x8c, 0xbb, 0xdc, 0x7c, 0x53, 0x90, 0x61, 0x4d, 0xf9,
-	0xec, 0x64, 0xaf, 0x77, 0x03, 0x87, 0x61, 0x3c, 0xf3, 0x14, 0xd7, 0x49, 0xff, 0x17, 0x52, 0xfd,
-	0xbf, 0x04, 0xc5, 0x41, 0xe0, 0xea, 0xf2, 0xca, 0xa5, 0x44, 0x25, 0xec, 0x52, 0xd3, 0xfc, 0x71,
-	0x04, 0x73, 0x6c, 0xc4, 0xfc, 0x48, 0x35, 0x6e, 0xbd, 0xbd, 0x92, 0x8d, 0xf1, 0x48, 0xee, 0x1d,
-	0x18, 0xef, 0x3e, 0x6c, 0xe6, 0xfe, 0xfd, 0x61, 0x73, 0x49, 0x41, 0x7f, 0x18, 0x5c, 0x79, 0x11,
-	0xbb, 0xe2, 0xd1, 0x35, 0xd5, 0xc6, 0x64, 0x1d, 0x6a, 0x32, 0x10, 0xc1, 0xed, 0xae, 0x22, 0xf2,
-	0x1a, 0x1d, 0x2b, 0x48, 0x0b, 0xaa, 0x19, 0x22, 0x68, 0xd0, 0x44, 0x26, 0x8f, 0x60, 0x31, 0x5e,
-	0x5b, 0x8e, 0xe7, 0xca, 0x4c, 0x57, 0x11, 0xb2, 0x10, 0xab, 0x0f, 0x51, 0x6b, 0xfe, 0x2d, 0x0f,
-	0xad, 0xe9, 0x84, 0xf1, 0x0d, 0x4c, 0x8b, 0xf9, 0x12, 0xca, 0xe8, 0x48, 0x46, 0x20, 0x07, 0x12,
-	0x8c, 0xbd, 0x46, 0x71, 0x4d, 0x9e, 0x01, 0xd8, 0x51, 0x14, 0x7a, 0x9d, 0x61, 0xc4, 0x84, 0xee,
-	0xe8, 0xf5, 0x09, 0x51, 0xec, 0xc7, 0xa0, 0x83, 0x75, 0x1d, 0x4e, 0x73, 0x6c, 0x97, 0x0a, 0x29,
-	0xe5, 0xcd, 0x3c, 0x83, 0x85, 0xac, 0xad, 0xcc, 0x4a, 0xcc, 0x3d, 0x35, 0x2a, 0x97, 0xf2, 0x7b,
-	0x47, 0xaa, 0xc5, 0xe4, 0xd5, 0xa8, 0x12, 0xa4, 0xd6, 0xf3, 0x1d, 0xf6, 0x0a, 0xf3, 0x57, 0xa5,
-	0x4a, 0x30, 0xff, 0x5b, 0x84, 0xfa, 0xad, 0x5a, 0xdc, 0xa1, 0xe3, 0x26, 0x94, 0x59, 0x18, 0xe2,
-	0xc7, 0x89, 0xfe, 0x50, 0x90, 0xc8, 0x9e, 0x2d, 0x7a, 0xe8, 0xae, 0x41, 0x71, 0x4d, 0xf6, 0xe0,
-	0x81, 0x1f, 0xf8, 0x96, 0xf0, 0x5c, 0x9f, 0x85, 0x42, 0x33, 0x9f, 0xb0, 0xdc, 0x5d, 0xa4, 0xe3,
-	0x06, 0x5d, 0xf1, 0x03, 0xff, 0x42, 0x6d, 0x6a, 0x5a, 0x39, 0xde, 0x25, 0x66, 0x4c, 0xdd, 0x36,
-	0xef, 0x23, 0xb6, 0x8c, 0xd8, 0x3a, 0x2a, 0xf7, 0x79, 0x5f, 0x62, 0xbe, 0x07, 0x0b, 0xb1, 0x53,
-	0x9b, 0xf7, 0x2d, 0xb7, 0x8d, 0x25, 0x69, 0xd0, 0x86, 0xd6, 0xee, 0xf3, 0xfe, 0x71, 0x9b, 0x7c,
-	0x05, 0x24, 0x41, 0xb9, 0xae, 0x0c, 0x43, 0xba, 0x53, 0x6d, 0xbb, 0x18, 0x23, 0x5d, 0xf7, 0xc2,
-	0x73, 0x8f, 0x77, 0xc9, 0x21, 0x6c, 0x8e, 0x63, 0xb5, 0x54, 0x04, 0x1d, 0x2f, 0xba, 0xb2, 0xb9,
-	0xe5, 0xf9, 0x8e, 0xd7, 0x65, 0xc2, 0xa8, 0xe2, 0x81, 0xb0, 0x96, 0x04, 0x7d, 0x2c, 0x41, 0x07,
-	0x88, 0x39, 0x55, 0x10, 0xf2, 0x25, 0x2c, 0x27, 0xc1, 0x27, 0x76, 0x35, 0xb4, 0x5b, 0x8c, 0x5f,
-	0x20, 0xc6, 0x6e, 0xc3, 0x4a, 0x14, 0x44, 0xf6, 0xc0, 0x42, 0x02, 0x4e, 0xd0, 0x80, 0xe8, 0x65,
-	0xdc, 0xba, 0x90, 0x3b, 0x31, 0xfe, 0x39, 0x18, 0xa9, 0x08, 0xb3, 0x46, 0x75, 0xec, 0x2a, 0x33,
-	0xdb, 0x55, 0x67, 0x71, 0xa0, 0x29, 0x37, 0xf4, 0x5b, 0xfe, 0x04, 0xad, 0x30, 0x7f, 0x03, 0xcd,
-	0x49, 0x70, 0xf2, 0x35, 0x7c, 0x7b, 0xca, 0x43, 0x8d, 0x3c, 0x06, 0xda, 0x9c, 0xe4, 0xcf, 0xbc,
-	0x29, 0xc0, 0x52, 0x8a, 0x51, 0x99, 0x18, 0x0e, 0xa2, 0xff, 0x87, 0x86, 0x7b, 0xb0, 0x9e, 0x70,
-	0xcb, 0xa4, 0x81, 0xa7, 0xf0, 0x59, 0x03, 0x0f, 0x5d, 0x0d, 0x6f, 0x6d, 0x4d, 0x27, 0xfc, 0xe2,
-	0xe7, 0x10, 0x3e, 0xe9, 0xc3, 0x17, 0x53, 0xe2, 0xd4, 0xee, 0x4a, 0x9f, 0x37, 0x3d, 0xd1, 0xd6,
-	0xa4, 0x48, 0xf5, 0xe9, 0xb2, 0x00, 0x8d, 0xf4, 0x3d, 0xc4, 0x5c, 0x84, 0xf9, 0xcc, 0xfc, 0x6a,
-	0x3e, 0x82, 0x7a, 0xea, 0xd6, 0x21, 0xa7, 0x88, 0x2b, 0x26, 0x84, 0xed, 0xc6, 0xfc, 0x14, 0x8b,
-	0xe6, 0xeb, 0x7c, 0x82, 0x94, 0x33, 0xa9, 0x44, 0x8e, 0x58, 0x28, 0xe2, 0x19, 0xbb, 0x46, 0x63,
-	0x51, 0x0e, 0x56, 0x9d, 0x41, 0xd0, 0xed, 0x5b, 0xf1, 0xbe, 0xcc, 0x7c, 0x89, 0x36, 0x50, 0xf9,
-	0x54, 0x83, 0x36, 0xa1, 0xce, 0xdb, 0x3c, 0x81, 0x14, 0x11, 0x02, 0xbc, 0xcd, 0x63, 0xc0, 0x77,
-	0xa0, 0x61, 0x77, 0xba, 0x5e, 0x82, 0x50, 0x84, 0x5d, 0x97, 0x3a, 0x0d, 0x31, 0x9d, 0xe4, 0xe5,
-	0x70, 0xe4, 0x9d, 0xc8, 0x44, 0x04, 0x4a, 0xdc, 0x8e, 0x7a, 0x9a, 0x88, 0x70, 0x9d, 0x1a, 0x16,
-	0x8b, 0x99, 0x61, 0xb1, 0x09, 0x65, 0x1e, 0x06, 0x23, 0x55, 0x81, 0x2a, 0x55, 0x82, 0xb9, 0x25,
-	0x9f, 0x32, 0x1e, 0xdf, 0x67, 0xa4, 0xe8, 0x6d, 0x7e, 0x0c, 0xc5, 0x1c, 0xa5, 0x03, 0xaa, 0xe9,
-	0x80, 0x52, 0x79, 0x2b, 0x64, 0xf3, 0xb6, 0x09, 0x75, 0x9b, 0xdf, 0x49, 0x89, 0xcd, 0x93, 0x94,
-	0x7c, 0x09, 0xcb, 0x03, 0x5b, 0x44, 0x96, 0xca, 0xae, 0x7e, 0x85, 0x12, 0xbe, 0xc2, 0xa2, 0xdc,
-	0x38, 0x90, 0xfa, 0x13, 0xf5, 0x2e, 0x3f, 0x82, 0x95, 0x14, 0x56, 0xfa, 0x45, 0xea, 0x2d, 0x63,
-	0x6a, 0x96, 0x12, 0xf4, 0x3e, 0xe7, 0x27, 0xb6, 0xe8, 0x99, 0xff, 0xc9, 0x8f, 0x1b, 0x23, 0x49,
-	0xe6, 0x9d, 0x23, 0xf6, 0x7e, 0xc7, 0x69, 0x72, 0x6c, 0xe8, 0xe1, 0x11, 0x85, 0xf8, 0xd0, 0x51,
-	0x24, 0x9c, 0x3d, 0x74, 0x14, 0xdd, 0xea, 0x43, 0xe7, 0x6b, 0xa8, 0xe1, 0x2d, 0xdc, 0x0a, 0xb8,
-	0xc0, 0xe1, 0x20, 0xfd, 0xfd, 0xab, 0x6b, 0xfa, 0xf6, 0xb9, 0x04, 0x3c, 0xe1, 0x82, 0x56, 0xb9,
-	0x5e, 0xa5, 0x6a, 0x5a, 0xcb, 0xd4, 0x34, 0x73, 0x28, 0xc3, 0xed, 0x43, 0xf9, 0x07, 0xb0, 0x7c,
-	0xe7, 0x2e, 0x38, 0x3e, 0xbc, 0xf2, 0xa9, 0xc3, 0xab, 0xfd, 0xf7, 0x22, 0x94, 0xf6, 0x9f, 0x5e,
-	0x9c, 0x92, 0x5f, 0x42, 0x19, 0x3f, 0x1d, 0x32, 0xe3, 0xbe, 0xdf, 0x9a, 0x75, 0x67, 0x24, 0x3f,
-	0x83, 0x12, 0x76, 0xd2, 0xf4, 0xdb, 0x7f, 0x6b, 0xc6, 0xfd, 0x51, 0x9a, 0x63, 0x77, 0x4d, 0xff,
-	0x17, 0xd0, 0x9a, 0x71, 0x99, 0x94, 0xf1, 0xab, 0x0a, 0xcf, 0xf8, 0x33, 0xd0, 0x9a, 0x75, 0xb5,
-	0x24, 0x0e, 0x2c, 0xdf, 0x9d, 0x54, 0xef, 0xf9, 0x97, 0xa0, 0x75, 0x5f, 0x02, 0x26, 0x2e, 0x90,
-	0x09, 0x93, 0xdf, 0x7d, 0xff, 0x18, 0xb4, 0xee, 0xcd, 0x9f, 0x07, 0x47, 0xef, 0x6e, 0x36, 0xf2,
-	0xef, 0x6f, 0x36, 0xf2, 0xff, 0xba, 0xd9, 0xc8, 0xbf, 0xfe, 0xb8, 0x91, 0x7b, 0xff, 0x71, 0x23,
-	0xf7, 0x8f, 0x8f, 0x1b, 0xb9, 0x67, 0x5f, 0xb9, 0x5e, 0xd4, 0x1b, 0x76, 0xb6, 0xbb, 0xc1, 0xd5,
-	0xce, 0xe3, 0x57, 0xe7, 0x6c, 0x30, 0x38, 0x63, 0xd1, 0xcb, 0x20, 0xec, 0xef, 0xdc, 0xfd, 0xbf,
-	0xd4, 0x99, 0xc3, 0x1f, 0x44, 0x7b, 0xff, 0x0b, 0x00, 0x00, 0xff, 0xff, 0xac, 0x59, 0xbf, 0x02,
-	0x7c, 0x12, 0x00, 0x00,
+	// 1929 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0xcd, 0x6f, 0xdb, 0xc8,
+	0x15, 0x17, 0xf5, 0x61, 0x49, 0x4f, 0xb2, 0x2d, 0x8f, 0xdd, 0x54, 0x96, 0x1d, 0xdb, 0xcb, 0x6e,
+	0x1b, 0x37, 0xdb, 0xca, 0xb1, 0x82, 0xed, 0x07, 0xd0, 0x2f, 0x29, 0x62, 0x6c, 0x61, 0x13, 0xd9,
+	0x3b, 0xb2, 0xd3, 0x36, 0x5b, 0x80, 0xa0, 0xa5, 0x31, 0x45, 0x48, 0x22, 0xb9, 0x1c, 0xca, 0x1b,
+	0x5f, 0x7b, 0xea, 0xa9, 0x08, 0xb0, 0xfd, 0x0f, 0xfa, 0x3f, 0x14, 0x7b, 0xe8, 0x1f, 0xb0, 0xb7,
+	0xee, 0xad, 0x05, 0x0a, 0xa4, 0x45, 0x72, 0x6a, 0xcf, 0x45, 0xcf, 0xc5, 0x7c, 0x90, 0xa2, 0x64,
+	0x4a, 0x71, 0xd0, 0xd3, 0xde, 0xe6, 0xbd, 0xf9, 0xbd, 0xc7, 0x1f, 0xdf, 0x9b, 0x79, 0xf3, 0x66,
+	0xa0, 0xec, 0x92, 0xe1, 0xb0, 0x77, 0x45, 0x0f, 0x8c, 0x2b, 0x6a, 0x1d, 0xf8, 0xd7, 0x2e, 0xa1,
+	0x55, 0xd7, 0x73, 0x7c, 0x07, 0x15, 0xe5, 0x4c, 0x95, 0xcd, 0x54, 0x2a, 0x01, 0xae, 0xeb, 0x5d,
+	0xbb, 0xbe, 0x73, 0xe0, 0x7a, 0x8e, 0x73, 0x29, 0x90, 0x95, 0x0d, 0xd3, 0x31, 0x1d, 0x3e, 0x3c,
+	0x60, 0x23, 0xa9, 0xdd, 0x35, 0x1d, 0xc7, 0x1c, 0x92, 0x03, 0x2e, 0x5d, 0x8c, 0x2f, 0x0f, 0x7c,
+	0x6b, 0x44, 0xa8, 0x6f, 0x8c, 0x5c, 0x01, 0x50, 0x3f, 0x4f, 0x41, 0x16, 0x93, 0x4f, 0xc7, 0x84,
+	0xfa, 0xa8, 0x06, 0x99, 0xcb, 0xe1, 0x98, 0xf6, 0xcb, 0xca, 0x9e, 0xb2, 0x5f, 0xa8, 0x55, 0xaa,
+	0xd1, 0x8f, 0x57, 0x25, 0xea, 0x31, 0x43, 0x1c, 0x27, 0xb0, 0x80, 0xa2, 0x03, 0x48, 0x93, 0x6e,
+	0xdf, 0x29, 0x27, 0xb9, 0xc9, 0x66, 0xac, 0x89, 0xd6, 0xed, 0x3b, 0xc7, 0x09, 0xcc, 0x81, 0xcc,
+	0xc0, 0xb2, 0x2f, 0x9d, 0x72, 0x6a, 0x81, 0x41, 0xcb, 0xbe, 0xe4, 0x06, 0x0c, 0xc8, 0x58, 0x7d,
+	0x3a, 0x26, 0xde, 0x75, 0x39, 0xbd, 0x80, 0xd5, 0xc7, 0x0c, 0xc1, 0x58, 0x71, 0x28, 0xfa, 0x15,
+	0xac, 0xbb, 0x9e, 0xd3, 0x25, 0x94, 0xea, 0xbd, 0x2b, 0xaa, 0x7b, 0x02, 0x54, 0xce, 0x70, 0x0f,
+	0xdf, 0x89, 0xf5, 0x70, 0x2a, 0xf0, 0xcd, 0x67, 0x1d, 0xa9, 0x38, 0x4e, 0xe0, 0x35, 0xe9, 0xa4,
+	0x79, 0x45, 0x83, 0x18, 0x7d, 0x02, 0x1b, 0xd3, 0x9e, 0xa9, 0xeb, 0xd8, 0x94, 0x94, 0x97, 0xb8,
+	0xeb, 0x7b, 0x6f, 0x75, 0x2d, 0xe0, 0xc7, 0x09, 0x8c, 0xa2, 0xbe, 0x85, 0xb6, 0x91, 0x85, 0xcc,
+	0x95, 0x31, 0x1c, 0x13, 0xf5, 0xef, 0x29, 0xc8, 0x05, 0x5a, 0xf4, 0x73, 0xc8, 0x93, 0x17, 0x5d,
+	0xe2, 0xfa, 0x96, 0x63, 0xcb, 0xd4, 0xec, 0xce, 0x7e, 0x47, 0x40, 0xb5, 0x00, 0x76, 0x9c, 0xc0,
+	0x13, 0x1b, 0xf4, 0x30, 0xc8, 0xab, 0x48, 0xd2, 0x56, 0xbc, 0xf1, 0x4c, 0x62, 0x1f, 0xc8, 0xc4,
+	0xa6, 0xe2, 0xa3, 0x2e, 0x3f, 0x18, 0xcd, 0xec, 0x03, 0x99, 0xd9, 0xf4, 0x22, 0x8b, 0xa9, 0xd4,
+	0x3e, 0x0c, 0x52, 0x9b, 0x59, 0x44, 0x6c, 0x26, 0xb7, 0xbf, 0x8e, 0xcf, 0xed, 0x9c, 0x04, 0x08,
+	0x17, 0xb7, 0x4c, 0xee, 0x6f, 0xe6, 0x24, 0x37, 0xcb, 0x7d, 0xef, 0xbf, 0xdd, 0xf7, 0xed, 0xb2,
+	0xfb, 0x85, 0x02, 0x30, 0xa1, 0x82, 0x10, 0xa4, 0x7b, 0x86, 0x6f, 0xf0, 0xd4, 0x16, 0x31, 0x1f,
+	0xa3, 0x3b, 0xb0, 0xd4, 0x27, 0x96, 0xd9, 0xf7, 0x79, 0xce, 0x52, 0x58, 0x4a, 0x68, 0x13, 0x72,
+	0xdd, 0xbe, 0x61, 0xd9, 0xba, 0xd5, 0xe3, 0x99, 0x49, 0xe1, 0x2c, 0x97, 0x5b, 0x3d, 0xf4, 0x2d,
+	0x58, 0x36, 0x3d, 0x67, 0xec, 0xea, 0xf6, 0x78, 0x74, 0x41, 0x3c, 0x5a, 0x4e, 0xef, 0xa5, 0xf6,
+	0x97, 0x71, 0x91, 0x2b, 0xdb, 0x42, 0x87, 0x7e, 0x06, 0x5b, 0x02, 0xe4, 0xf7, 0x3d, 0x42, 0xfb,
+	0xce, 0xb0, 0xa7, 0xbb, 0xc4, 0xeb, 0x12, 0xdb, 0x37, 0x4c, 0x42, 0xcb, 0x19, 0x6e, 0xb2, 0xc9,
+	0x21, 0x67, 0x01, 0xe2, 0x74, 0x02, 0x50, 0x3f, 0x82, 0xd5, 0x13, 0x97, 0x78, 0x86, 0xef, 0x78,
+	0xa7, 0xe3, 0x8b, 0x01, 0xb9, 0xa6, 0x68, 0x0b, 0xf2, 0xe6, 0xa1, 0xee, 0x72, 0x49, 0xfe, 0x43,
+	0xce, 0x3c, 0x14, 0xb3, 0x7c, 0xb2, 0x16, 0x4c, 0x26, 0xe5, 0x64, 0x4d, 0x4c, 0xaa, 0x7f, 0x52,
+	0x20, 0x17, 0x78, 0x43, 0x2b, 0x90, 0xb4, 0x7a, 0xd2, 0x3e, 0x69, 0xf5, 0x50, 0x19, 0xb2, 0x46,
+	0xaf, 0xe7, 0x11, 0x4a, 0xa5, 0x5d, 0x20, 0xb2, 0x18, 0x8c, 0x88, 0x6f, 0xe8, 0x63, 0xcf, 0xe2,
+	0x31, 0xc8, 0xe3, 0x2c, 0x93, 0xcf, 0x3d, 0x8b, 0x85, 0x8d, 0x3a, 0xdd, 0x01, 0xf1, 0xf9, 0x22,
+	0xcc, 0x63, 0x29, 0xa1, 0x0d, 0xc8, 0x50, 0xdf, 0x18, 0x10, 0xbe, 0xd0, 0x52, 0x58, 0x08, 0xe8,
+	0x87, 0x90, 0x15, 0xcc, 0xa8, 0x5c, 0x3d, 0x77, 0xa7, 0x33, 0x3c, 0xf3, 0xa7, 0x38, 0x40, 0xab,
+	0xbf, 0x55, 0xa0, 0x3c, 0xaf, 0x6c, 0xa0, 0x1a, 0x64, 0x83, 0x35, 0x29, 0x36, 0x6b, 0x79, 0xda,
+	0xeb, 0x04, 0x8a, 0x03, 0x20, 0xaa, 0x41, 0xce, 0x91, 0x1f, 0x2b, 0x27, 0xf7, 0x52, 0xfb, 0x85,
+	0xda, 0x9d, 0x78, 0x2a, 0x38, 0xc4, 0xa9, 0x7f, 0x50, 0x60, 0x73, 0x6e, 0x81, 0x41, 0x3f, 0x86,
+	0x42, 0x74, 0x77, 0xbc, 0x8d, 0x09, 0xf4, 0x26, 0xbb, 0xe0, 0x27, 0x50, 0x9c, 0x5a, 0xfd, 0xb1,
+	0xa5, 0x3d, 0xf2, 0x2d, 0x5c, 0xe8, 0x4d, 0x56, 0xb9, 0xfa, 0x79, 0x12, 0x36, 0x03, 0xe1, 0x66,
+	0x70, 0x10, 0xa4, 0xbb, 0x4e, 0x8f, 0x70, 0x3e, 0xcb, 0x98, 0x8f, 0xc3, 0xf5, 0x9f, 0x8c, 0xac,
+	0xff, 0x12, 0xa4, 0x86, 0x8e, 0x29, 0xd3, 0xcb, 0x86, 0x0c, 0x15, 0x56, 0x97, 0xbc, 0xac, 0x1f,
+	0x1a, 0x2c, 0x91, 0x2b, 0x62, 0xfb, 0x62, 0xe1, 0x16, 0x6a, 0xeb, 0xd3, 0x1c, 0x35, 0x36, 0xd7,
+	0x28, 0x7f, 0xf9, 0x6a, 0x37, 0xf1, 0xef, 0x57, 0xbb, 0x25, 0x01, 0xfd, 0x9e, 0x33, 0xb2, 0x7c,
+	0x32, 0x72, 0xfd, 0x6b, 0x2c, 0x8d, 0xd1, 0x36, 0xe4, 0x19, 0x11, 0xea, 0x1a, 0x5d, 0x51, 0xc8,
+	0xf3, 0x78, 0xa2, 0x40, 0x15, 0xc8, 0x4d, 0x15, 0x82, 0x22, 0x0e, 0x65, 0x74, 0x0f, 0x56, 0x83,
+	0xb1, 0xde, 0xb3, 0x4c, 0x16, 0xe9, 0x1c, 0x87, 0xac, 0x04, 0xea, 0x26, 0xd7, 0xaa, 0x7f, 0x51,
+	0xa0, 0x32, 0xbf, 0x60, 0x7c, 0x0d, 0xc3, 0xa2, 0x7e, 0x06, 0x19, 0xee, 0x88, 0x31, 0x60, 0x1d,
+	0x0b, 0xe7, 0x9e, 0xc7, 0x7c, 0x8c, 0x9e, 0x03, 0x18, 0xbe, 0xef, 0x59, 0x17, 0x63, 0x9f, 0x50,
+	0xb9, 0xa2, 0xb7, 0x63, 0x58, 0xd4, 0x03, 0x50, 0x63, 0x5b, 0xd2, 0xd9, 0x98, 0xd8, 0x45, 0x28,
+	0x45, 0xbc, 0xa9, 0x6d, 0x58, 0x99, 0xb6, 0x65, 0x51, 0x09, 0x6a, 0x4f, 0x1e, 0xb3, 0x21, 0xda,
+	0x90, 0xa5, 0x96, 0x07, 0x2f, 0x8f, 0x85, 0xc0, 0xb4, 0x96, 0xdd, 0x23, 0x2f, 0x78, 0xfc, 0x72,
+	0x58, 0x08, 0xea, 0x7f, 0x53, 0x50, 0x98, 0xc9, 0xc5, 0x8d, 0x72, 0xbc, 0x01, 0x19, 0xe2, 0x79,
+	0x8e, 0x17, 0xf8, 0xe3, 0x02, 0x43, 0xf6, 0x0d, 0xda, 0xe7, 0xee, 0x8a, 0x98, 0x8f, 0xd1, 0x43,
+	0xb8, 0x63, 0x3b, 0xb6, 0x4e, 0x2d, 0xd3, 0x26, 0x1e, 0x95, 0x95, 0x8f, 0xea, 0xe6, 0x21, 0x2f,
+	0xc7, 0x45, 0xbc, 0x6e, 0x3b, 0x76, 0x47, 0x4c, 0xca, 0xb2, 0x72, 0x74, 0x88, 0xd4, 0xa0, 0x74,
+	0x1b, 0xee, 0x80, 0x63, 0x33, 0x1c, 0x5b, 0xe0, 0xca, 0xba, 0x3b, 0x60, 0x98, 0xf7, 0x61, 0x25,
+	0x70, 0x6a, 0xb8, 0x03, 0xdd, 0xac, 0xf1, 0x94, 0x14, 0x71, 0x51, 0x6a, 0xeb, 0xee, 0xe0, 0xa8,
+	0x86, 0x3e, 0x00, 0x14, 0xa2, 0x4c, 0x93, 0xd1, 0x60, 0xee, 0xc4, 0xb2, 0x5d, 0x0d, 0x90, 0xa6,
+	0xd9, 0xb1, 0xcc, 0xa3, 0x43, 0xd4, 0x84, 0xdd, 0x09, 0x57, 0x5d, 0x30, 0xb8, 0xb0, 0xfc, 0x91,
+	0xe1, 0xea, 0x96, 0xdd, 0xb3, 0xba, 0x84, 0x96, 0x73, 0xfc, 0x40, 0xd8, 0x0a, 0x49, 0x1f, 0x31,
+	0x50, 0x83, 0x63, 0x5a, 0x02, 0x82, 0xee, 0xc3, 0x5a, 0x48, 0x3e, 0xb4, 0xcb, 0x73, 0xbb, 0xd5,
+	0xe0, 0x07, 0x02, 0x6c, 0x15, 0xd6, 0x7d, 0xc7, 0x37, 0x86, 0x3a, 0x2f, 0xc0, 0x21, 0x1a, 0x38,
+	0x7a, 0x8d, 0x4f, 0x75, 0xd8, 0x4c, 0x80, 0xff, 0x04, 0xca, 0x11, 0x86, 0xd3, 0x46, 0x05, 0xbe,
+	0xaa, 0xd4, 0xe9, 0x55, 0xd5, 0x0e, 0x88, 0x46, 0xdc, 0xe0, 0x6f, 0xd8, 0x31, 0x5a, 0xaa, 0x3e,
+	0x85, 0x8d, 0x38, 0x38, 0xfa, 0x10, 0xbe, 0x39, 0xe7, 0xa3, 0x65, 0x85, 0x13, 0xdd, 0x88, 0xf3,
+	0xa7, 0xfe, 0x39, 0x0d, 0xa5, 0x48, 0x45, 0x25, 0x74, 0x3c, 0xf4, 0xff, 0x9f, 0x32, 0xdc, 0x87,
+	0xed, 0xb0, 0xb6, 0xc4, 0x35, 0x3c, 0xc9, 0x77, 0x6a, 0x78, 0xf0, 0xa6, 0x37, 0x33, 0x35, 0xbf,
+	0xe0, 0xa7, 0xde, 0xa5, 0xe0, 0xa3, 0x01, 0xdc, 0x9d, 0xc3, 0x53, 0xba, 0x4b, 0xbf, 0x5b, 0xf7,
+	0x84, 0x2b, 0x71, 0x4c, 0xe5, 0xc7, 0x7e, 0x00, 0x4b, 0xd4, 0x37, 0xfc, 0x31, 0xe5, 0x27, 0xf9,
+	0x4a, 0x6d, 0x67, 0x5e, 0x28, 0x3b, 0x1c, 0x85, 0x25, 0x1a, 0x7d, 0x1b, 0x56, 0x2e, 0x0d, 0x6b,
+	0x38, 0xf6, 0x88, 0xee, 0x11, 0x83, 0x3a, 0xb6, 0x2c, 0x68, 0xcb, 0x52, 0x8b, 0xb9, 0x12, 0x9d,
+	0x42, 0xc1, 0xf7, 0x0c, 0x9b, 0x5a, 0xac, 0x6f, 0xa6, 0xe5, 0xec, 0x5e, 0xea, 0x26, 0xf3, 0xd9,
+	0x6f, 0x9c, 0x85, 0x06, 0x8d, 0x34, 0x2b, 0x62, 0x38, 0xea, 0x42, 0x9e, 0x10, 0xe3, 0x11, 0xd1,
+	0x0d, 0x9f, 0x17, 0x33, 0xca, 0x4f, 0x88, 0x65, 0x7e, 0x42, 0x8c, 0x47, 0xa4, 0x2e, 0xb5, 0xea,
+	0xef, 0x15, 0xa8, 0xcc, 0x77, 0x1d, 0xf9, 0x71, 0xe5, 0x9d, 0x7e, 0xfc, 0x47, 0x90, 0x66, 0x57,
+	0x3e, 0xb9, 0x5a, 0x2a, 0x55, 0x71, 0x1f, 0xac, 0x06, 0xf7, 0xc1, 0xea, 0x59, 0x70, 0x1f, 0x6c,
+	0xe4, 0x18, 0xf9, 0x97, 0xff, 0xd8, 0x55, 0x30, 0xb7, 0x50, 0x57, 0xa0, 0x18, 0xbd, 0xf2, 0xa9,
+	0xab, 0xb0, 0x3c, 0x75, 0x55, 0x50, 0xef, 0x41, 0x21, 0x72, 0xc1, 0x63, 0x0d, 0xdb, 0x88, 0x50,
+	0x6a, 0x98, 0xc1, 0x51, 0x10, 0x88, 0xea, 0x4b, 0x25, 0x44, 0xb2, 0xf6, 0x9f, 0x21, 0xaf, 0x88,
+	0x47, 0x83, 0xeb, 0x4c, 0x1e, 0x07, 0x22, 0xeb, 0x61, 0x2f, 0x86, 0x4e, 0x77, 0xa0, 0x07, 0xf3,
+	0x8c, 0x76, 0x1a, 0x17, 0xb9, 0xf2, 0x99, 0x04, 0xed, 0x42, 0xc1, 0xad, 0xb9, 0x21, 0x24, 0xc5,
+	0x21, 0xe0, 0xd6, 0xdc, 0x00, 0xf0, 0x1e, 0x14, 0x8d, 0x8b, 0xae, 0x15, 0x22, 0xc4, 0xd9, 0x58,
+	0x60, 0x3a, 0x09, 0x51, 0x7b, 0xe1, 0xcf, 0xf1, 0xdb, 0x45, 0x6c, 0xd1, 0x47, 0x90, 0x76, 0x0d,
+	0xbf, 0x2f, 0x6b, 0x3e, 0x1f, 0x47, 0xfa, 0xf2, 0xd4, 0x54, 0x5f, 0xbe, 0x01, 0x19, 0xd7, 0x73,
+	0xae, 0xc4, 0x62, 0xcf, 0x61, 0x21, 0xa8, 0xfb, 0x50, 0x0c, 0x42, 0xf6, 0x96, 0x10, 0x7d, 0xa1,
+	0x4c, 0xa0, 0x3c, 0x46, 0x51, 0x42, 0x79, 0x49, 0x28, 0x12, 0xb7, 0xe4, 0x74, 0xdc, 0x76, 0xa1,
+	0x60, 0xb8, 0x37, 0x42, 0x62, 0xb8, 0x61, 0x48, 0xee, 0xc3, 0xda, 0xd0, 0xa0, 0xbe, 0x2e, 0xa2,
+	0x2b, 0x7f, 0x21, 0xcd, 0x7f, 0x61, 0x95, 0x4d, 0x34, 0x98, 0xfe, 0x58, 0xfc, 0xcb, 0xf7, 0x61,
+	0x3d, 0x82, 0x65, 0x7e, 0xf9, 0x29, 0x97, 0xe1, 0xa1, 0x29, 0x85, 0xe8, 0xba, 0xeb, 0x1e, 0x1b,
+	0xb4, 0xaf, 0xfe, 0x47, 0x99, 0x2c, 0x8c, 0x30, 0x98, 0x37, 0xba, 0x99, 0xdb, 0x75, 0x2e, 0xe1,
+	0x09, 0x2d, 0xfb, 0x74, 0x2e, 0x04, 0xe7, 0xbb, 0x38, 0xef, 0xa6, 0xcf, 0x77, 0x71, 0xb2, 0x09,
+	0x01, 0x7d, 0x08, 0x79, 0xfe, 0x22, 0xa2, 0x3b, 0xae, 0xd8, 0x65, 0xd1, 0x52, 0x2b, 0x9e, 0x4c,
+	0xaa, 0xa7, 0x0c, 0x70, 0xe2, 0x52, 0x9c, 0x73, 0xe5, 0x28, 0x92, 0xd3, 0xfc, 0x54, 0x4e, 0xa7,
+	0xfa, 0x1f, 0x98, 0xed, 0x7f, 0xbe, 0x0b, 0x6b, 0x37, 0xae, 0xdd, 0x93, 0x3e, 0x41, 0x89, 0xf4,
+	0x09, 0xf7, 0xff, 0x95, 0x84, 0xd2, 0xec, 0x06, 0x45, 0x3b, 0x7c, 0xbb, 0xeb, 0x58, 0xfb, 0xf8,
+	0x5c, 0xeb, 0x9c, 0xe9, 0x9d, 0xb3, 0xfa, 0xd9, 0x79, 0x47, 0x3f, 0x6f, 0x7f, 0xd4, 0x3e, 0xf9,
+	0x65, 0xbb, 0x94, 0x40, 0xbb, 0xb0, 0x15, 0x33, 0x8f, 0xb5, 0x47, 0x5a, 0xeb, 0x99, 0xd6, 0x2c,
+	0x29, 0xe8, 0x7d, 0xd8, 0x8b, 0x01, 0xd4, 0x4f, 0x4f, 0xf5, 0x53, 0x7c, 0xf2, 0x48, 0xeb, 0x74,
+	0xb4, 0x66, 0x29, 0x89, 0xee, 0xc2, 0x66, 0x0c, 0xaa, 0xd3, 0x3a, 0x6a, 0x6b, 0xcd, 0x52, 0x0a,
+	0xed, 0xc1, 0x76, 0xdc, 0xf4, 0x79, 0xe3, 0x69, 0xeb, 0xec, 0x4c, 0x6b, 0x96, 0xd2, 0xe8, 0x3d,
+	0xb8, 0x1b, 0xf7, 0x99, 0xa3, 0x23, 0xac, 0x1d, 0xd5, 0x19, 0x24, 0x33, 0xc7, 0xc9, 0xe3, 0x56,
+	0xbb, 0xfe, 0xa4, 0xf5, 0x5c, 0x6b, 0x96, 0x96, 0xe6, 0xb0, 0x78, 0x5c, 0x6f, 0x3d, 0xd1, 0x9a,
+	0xa5, 0xec, 0x1c, 0x07, 0x8f, 0xea, 0xed, 0x47, 0xda, 0x13, 0x86, 0xc8, 0xcd, 0x61, 0xd1, 0x3e,
+	0x39, 0xd3, 0x9f, 0x6a, 0x4f, 0x1b, 0x1a, 0x2e, 0xe5, 0x2b, 0xe9, 0xdf, 0xfd, 0x71, 0x27, 0x51,
+	0xfb, 0x6b, 0x0a, 0xd2, 0xf5, 0x67, 0x9d, 0x16, 0xfa, 0x05, 0x64, 0x78, 0x99, 0x42, 0x0b, 0x9e,
+	0xb1, 0x2a, 0x8b, 0x9e, 0x42, 0xd0, 0x4f, 0x21, 0xcd, 0x77, 0xed, 0xfc, 0x47, 0xad, 0xca, 0x82,
+	0x67, 0x11, 0x66, 0xce, 0x77, 0xf2, 0xfc, 0x27, 0xae, 0xca, 0x82, 0x37, 0x12, 0xc6, 0x5f, 0xec,
+	0xa6, 0x05, 0x0f, 0x5e, 0x95, 0x45, 0x2f, 0x26, 0xa8, 0x07, 0x6b, 0x37, 0x2f, 0x60, 0xb7, 0x7c,
+	0xfc, 0xaa, 0xdc, 0xb6, 0xaf, 0x40, 0x26, 0xa0, 0x98, 0x0b, 0xcd, 0x6d, 0x1f, 0xc2, 0x2a, 0xb7,
+	0x6e, 0x0b, 0x1a, 0xda, 0xf3, 0x0f, 0x4c, 0xcb, 0xef, 0x8f, 0x2f, 0xaa, 0x5d, 0x67, 0x74, 0xf0,
+	0xe0, 0xc5, 0x29, 0x19, 0x0e, 0xdb, 0xc4, 0xff, 0xcc, 0xf1, 0x06, 0x07, 0x37, 0xdf, 0x4f, 0xbf,
+	0x7c, 0xbd, 0xa3, 0x7c, 0xf5, 0x7a, 0x47, 0xf9, 0xe7, 0xeb, 0x1d, 0xe5, 0xe5, 0x9b, 0x9d, 0xc4,
+	0x57, 0x6f, 0x76, 0x12, 0x7f, 0x7b, 0xb3, 0x93, 0xb8, 0x58, 0xe2, 0x47, 0xdf, 0xc3, 0xff, 0x0d,
+	0x00, 0x19, 0xb7, 0x64, 0xc9, 0x74, 0x15, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -3162,6 +3327,37 @@ func (m *DVSRequestResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ResumeAttempts != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ResumeAttempts))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Transitions) > 0 {
+		for iNdEx := len(m.Transitions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Transitions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.FailureReason) > 0 {
+		i -= len(m.FailureReason)
+		copy(dAtA[i:], m.FailureReason)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.FailureReason)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Status != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.ResponseProcessDvsResponse != nil {
 		{
 			size, err := m.ResponseProcessDvsResponse.MarshalToSizedBuffer(dAtA[:i])
@@ -3213,6 +3409,42 @@ func (m *DVSRequestResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DVSRequestStatusTransition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DVSRequestStatusTransition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DVSRequestStatusTransition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n34, err34 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err34 != nil {
+		return 0, err34
+	}
+	i -= n34
+	i = encodeVarintTypes(dAtA, i, uint64(n34))
+	i--
+	dAtA[i] = 0x12
+	if m.Status != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *RequestFlush) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4117,6 +4349,36 @@ func (m *DVSRequestResult) Size() (n int) {
 		l = m.ResponseProcessDvsResponse.Size()
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	if m.Status != 0 {
+		n += 1 + sovTypes(uint64(m.Status))
+	}
+	l = len(m.FailureReason)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Transitions) > 0 {
+		for _, e := range m.Transitions {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.ResumeAttempts != 0 {
+		n += 1 + sovTypes(uint64(m.ResumeAttempts))
+	}
+	return n
+}
+
+func (m *DVSRequestStatusTransition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovTypes(uint64(m.Status))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovTypes(uint64(l))
 	return n
 }
 
@@ -7351,6 +7613,212 @@ func (m *DVSRequestResult) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= DVSRequestStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailureReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transitions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Transitions = append(m.Transitions, DVSRequestStatusTransition{})
+			if err := m.Transitions[len(m.Transitions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResumeAttempts", wireType)
+			}
+			m.ResumeAttempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResumeAttempts |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DVSRequestStatusTransition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DVSRequestStatusTransition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DVSRequestStatusTransition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= DVSRequestStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
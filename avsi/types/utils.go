@@ -1,6 +1,9 @@
 package types
 
 import (
+	"strings"
+	"time"
+
 	"github.com/cosmos/gogoproto/proto"
 
 	"github.com/0xPellNetwork/pelldvs/crypto/tmhash"
@@ -15,3 +18,54 @@ func (d *DVSRequest) Hash() DVSRequestHash {
 	}
 	return tmhash.Sum(raw)
 }
+
+// dvsRequestStatusPrefix is the prefix of the DVSRequestStatus value names,
+// left out of the status names
+const dvsRequestStatusPrefix = "DVS_REQUEST_STATUS_"
+
+// Name returns the name of the status without its prefix, e.g. FAILED
+func (s DVSRequestStatus) Name() string {
+	return strings.TrimPrefix(s.String(), dvsRequestStatusPrefix)
+}
+
+// SetStatus moves the request to the status and records the time of the
+// transition
+func (r *DVSRequestResult) SetStatus(status DVSRequestStatus, now time.Time) {
+	r.Status = status
+	r.Transitions = append(r.Transitions, DVSRequestStatusTransition{Status: status, Time: now})
+}
+
+// Fail moves the request to the FAILED status for the reason
+func (r *DVSRequestResult) Fail(reason string, now time.Time) {
+	r.FailureReason = reason
+	r.SetStatus(DVS_REQUEST_STATUS_FAILED, now)
+}
+
+// Cancel moves the request to the CANCELLED status for the reason
+func (r *DVSRequestResult) Cancel(reason string, now time.Time) {
+	r.FailureReason = reason
+	r.SetStatus(DVS_REQUEST_STATUS_CANCELLED, now)
+}
+
+// SkipNotMember moves the request to the NOT_MEMBER status for the reason
+func (r *DVSRequestResult) SkipNotMember(reason string, now time.Time) {
+	r.FailureReason = reason
+	r.SetStatus(DVS_REQUEST_STATUS_NOT_MEMBER, now)
+}
+
+// IsTerminal reports whether a request in the status is done with
+func (s DVSRequestStatus) IsTerminal() bool {
+	switch s {
+	case DVS_REQUEST_STATUS_FINALIZED, DVS_REQUEST_STATUS_FAILED, DVS_REQUEST_STATUS_CANCELLED,
+		DVS_REQUEST_STATUS_NOT_MEMBER:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsRetryable reports whether a request in the status may be handled again
+// from the start
+func (s DVSRequestStatus) IsRetryable() bool {
+	return s == DVS_REQUEST_STATUS_FAILED || s == DVS_REQUEST_STATUS_CANCELLED
+}
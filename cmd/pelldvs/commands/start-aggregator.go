@@ -12,12 +12,14 @@ import (
 	"github.com/0xPellNetwork/pelldvs/aggregator/rpc"
 	cfg "github.com/0xPellNetwork/pelldvs/config"
 	"github.com/0xPellNetwork/pelldvs/libs/cli"
+	cmtos "github.com/0xPellNetwork/pelldvs/libs/os"
 	"github.com/0xPellNetwork/pelldvs/utils"
 )
 
 const (
-	flagRPCAddress = "address"
-	flagTimeout    = "timeout"
+	flagRPCAddress  = "address"
+	flagGRPCAddress = "grpc-address"
+	flagTimeout     = "timeout"
 )
 
 var aggregatorConfigFile string
@@ -35,6 +37,7 @@ func init() {
 
 func init() {
 	StartAggregatorCmd.Flags().String(flagRPCAddress, "", "RPC server listen address")
+	StartAggregatorCmd.Flags().String(flagGRPCAddress, "", "gRPC server listen address, the gRPC server is off when empty")
 	StartAggregatorCmd.Flags().String(flagTimeout, "", "Aggregation operation timeout")
 }
 
@@ -45,6 +48,7 @@ func startAggregator(cmd *cobra.Command, args []string) error {
 
 func runAggregatorService(cmd *cobra.Command) error {
 	rpcAddress := viper.GetString(flagRPCAddress)
+	grpcAddress := viper.GetString(flagGRPCAddress)
 	timeout := viper.GetString(flagTimeout)
 
 	homeDir := cmd.Flags().Lookup(cli.HomeFlag).Value.String()
@@ -64,6 +68,9 @@ func runAggregatorService(cmd *cobra.Command) error {
 	if rpcAddress != "" {
 		aggregatorConfig.AggregatorRPCServer = rpcAddress
 	}
+	if grpcAddress != "" {
+		aggregatorConfig.AggregatorGRPCServer = grpcAddress
+	}
 	if timeout != "" {
 		aggregatorConfig.OperatorResponseTimeout = timeout
 	}
@@ -98,8 +105,20 @@ func runAggregatorService(cmd *cobra.Command) error {
 		return fmt.Errorf("failed to start aggregator: %v", err)
 	}
 
-	logger.Info("Aggregator started", "RPC address", rpcAddress)
+	logger.Info("Aggregator started",
+		"RPC address", aggregatorConfig.AggregatorRPCServer,
+		"gRPC address", aggregatorConfig.AggregatorGRPCServer,
+	)
+
+	// Stop upon receiving SIGTERM or CTRL-C, draining the in-flight tasks.
+	cmtos.TrapSignal(logger, func() {
+		if rpcAggregator.IsRunning() {
+			if err := rpcAggregator.Stop(); err != nil {
+				logger.Error("unable to stop the aggregator", "error", err)
+			}
+		}
+	})
 
-	// Keep the service running until an interrupt signal is received
+	// Run forever.
 	select {}
 }
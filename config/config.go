@@ -775,12 +775,42 @@ func getDefaultMoniker() string {
 
 // PellConfig defines the configuration structure for a Pell node
 type PellConfig struct {
-	RootDir                          string `mapstructure:"home"`
-	OperatorBLSPrivateKeyStorePath   string `mapstructure:"operator_bls_private_key_store_path"`
-	OperatorECDSAPrivateKeyStorePath string `mapstructure:"operator_ecdsa_private_key_store_path"`
-	AggregatorRPCURL                 string `mapstructure:"aggregator_rpc_url"`
-	InteractorConfigPath             string `mapstructure:"interactor_config_path"`
-}
+	RootDir                          string  `mapstructure:"home"`
+	OperatorBLSPrivateKeyStorePath   string  `mapstructure:"operator_bls_private_key_store_path"`
+	OperatorECDSAPrivateKeyStorePath string  `mapstructure:"operator_ecdsa_private_key_store_path"`
+	AggregatorRPCURL                 string  `mapstructure:"aggregator_rpc_url"`
+	AggregatorStrategy               string  `mapstructure:"aggregator_strategy"`
+	AggregatorMode                   string  `mapstructure:"aggregator_mode"`
+	AggregatorConfigPath             string  `mapstructure:"aggregator_config_path"`
+	EmbeddedAggregatorServe          bool    `mapstructure:"embedded_aggregator_serve"`
+	InteractorConfigPath             string  `mapstructure:"interactor_config_path"`
+	RequestMaxAgeBlocks              int64   `mapstructure:"request_max_age_blocks"`
+	RequestAllowedChains             []int64 `mapstructure:"request_allowed_chains"`
+	RequestMaxDataSize               int     `mapstructure:"request_max_data_size"`
+
+	OperatorGroupNumbers []uint32 `mapstructure:"operator_group_numbers"`
+
+	RequestResumeMaxAttempts int           `mapstructure:"request_resume_max_attempts"`
+	RequestResumeBackoff     time.Duration `mapstructure:"request_resume_backoff"`
+
+	EventWorkers   int `mapstructure:"event_workers"`
+	EventQueueSize int `mapstructure:"event_queue_size"`
+
+	RequestQueueSize    int           `mapstructure:"request_queue_size"`
+	RequestWorkers      int           `mapstructure:"request_workers"`
+	RequestDrainTimeout time.Duration `mapstructure:"request_drain_timeout"`
+	RequestTimeout      time.Duration `mapstructure:"request_timeout"`
+}
+
+// Aggregator modes of the node
+const (
+	// AggregatorModeRemote submits operator responses to the aggregators
+	// listed in aggregator_rpc_url
+	AggregatorModeRemote = "remote"
+	// AggregatorModeEmbedded aggregates operator responses in the node
+	// process, with no separate aggregator
+	AggregatorModeEmbedded = "embedded"
+)
 
 // DefaultPellConfig returns the default Pell configuration
 func DefaultPellConfig() *PellConfig {
@@ -788,12 +818,72 @@ func DefaultPellConfig() *PellConfig {
 		OperatorBLSPrivateKeyStorePath:   "operator.bls.key.json",
 		OperatorECDSAPrivateKeyStorePath: "operator.ecdsa.key.json",
 		AggregatorRPCURL:                 "127.0.0.1:26653",
+		AggregatorStrategy:               "failover",
+		AggregatorMode:                   AggregatorModeRemote,
 		InteractorConfigPath:             "interactor_config.json",
+		RequestMaxAgeBlocks:              DefaultRequestMaxAgeBlocks,
+		RequestMaxDataSize:               DefaultRequestMaxDataSize,
+		RequestResumeMaxAttempts:         3,
+		RequestResumeBackoff:             5 * time.Second,
+		EventWorkers:                     8,
+		EventQueueSize:                   1024,
+		RequestQueueSize:                 1024,
+		RequestWorkers:                   16,
+		RequestDrainTimeout:              30 * time.Second,
+		RequestTimeout:                   2 * time.Minute,
 	}
 }
 
+// DefaultRequestMaxDataSize is the default limit on the data of DVS requests
+const DefaultRequestMaxDataSize = 1024 * 1024
+
+// DefaultRequestMaxAgeBlocks is the default number of blocks a DVS request
+// height may be behind the latest block of its chain
+const DefaultRequestMaxAgeBlocks = 7200
+
 func (p *PellConfig) ValidateBasic() error {
 	// TODO(jimmy): validate pell config
+	switch p.AggregatorStrategy {
+	case "", "failover", "broadcast":
+	default:
+		return fmt.Errorf("unknown aggregator_strategy %q, must be failover or broadcast", p.AggregatorStrategy)
+	}
+	switch p.AggregatorMode {
+	case "", AggregatorModeRemote, AggregatorModeEmbedded:
+	default:
+		return fmt.Errorf("unknown aggregator_mode %q, must be %s or %s",
+			p.AggregatorMode, AggregatorModeRemote, AggregatorModeEmbedded)
+	}
+	if p.RequestMaxAgeBlocks < 0 {
+		return errors.New("request_max_age_blocks can't be negative")
+	}
+	if p.RequestMaxDataSize < 0 {
+		return errors.New("request_max_data_size can't be negative")
+	}
+	if p.RequestResumeMaxAttempts < 0 {
+		return errors.New("request_resume_max_attempts can't be negative")
+	}
+	if p.RequestResumeBackoff < 0 {
+		return errors.New("request_resume_backoff can't be negative")
+	}
+	if p.EventWorkers <= 0 {
+		return errors.New("event_workers must be positive")
+	}
+	if p.EventQueueSize <= 0 {
+		return errors.New("event_queue_size must be positive")
+	}
+	if p.RequestQueueSize < 0 {
+		return errors.New("request_queue_size can't be negative")
+	}
+	if p.RequestWorkers <= 0 {
+		return errors.New("request_workers must be positive")
+	}
+	if p.RequestDrainTimeout < 0 {
+		return errors.New("request_drain_timeout can't be negative")
+	}
+	if p.RequestTimeout < 0 {
+		return errors.New("request_timeout can't be negative")
+	}
 	return nil
 }
 
@@ -815,6 +905,42 @@ func LoadPellConfig(configFilePath string) (*PellConfig, error) {
 	if pellConfig.AggregatorRPCURL == "" {
 		pellConfig.AggregatorRPCURL = defaultConfig.AggregatorRPCURL
 	}
+	if pellConfig.AggregatorStrategy == "" {
+		pellConfig.AggregatorStrategy = defaultConfig.AggregatorStrategy
+	}
+	if pellConfig.AggregatorMode == "" {
+		pellConfig.AggregatorMode = defaultConfig.AggregatorMode
+	}
+	if !v.IsSet("pell.request_max_age_blocks") {
+		pellConfig.RequestMaxAgeBlocks = defaultConfig.RequestMaxAgeBlocks
+	}
+	if !v.IsSet("pell.request_max_data_size") {
+		pellConfig.RequestMaxDataSize = defaultConfig.RequestMaxDataSize
+	}
+	if !v.IsSet("pell.request_resume_max_attempts") {
+		pellConfig.RequestResumeMaxAttempts = defaultConfig.RequestResumeMaxAttempts
+	}
+	if !v.IsSet("pell.request_resume_backoff") {
+		pellConfig.RequestResumeBackoff = defaultConfig.RequestResumeBackoff
+	}
+	if !v.IsSet("pell.event_workers") {
+		pellConfig.EventWorkers = defaultConfig.EventWorkers
+	}
+	if !v.IsSet("pell.event_queue_size") {
+		pellConfig.EventQueueSize = defaultConfig.EventQueueSize
+	}
+	if !v.IsSet("pell.request_queue_size") {
+		pellConfig.RequestQueueSize = defaultConfig.RequestQueueSize
+	}
+	if !v.IsSet("pell.request_workers") {
+		pellConfig.RequestWorkers = defaultConfig.RequestWorkers
+	}
+	if !v.IsSet("pell.request_drain_timeout") {
+		pellConfig.RequestDrainTimeout = defaultConfig.RequestDrainTimeout
+	}
+	if !v.IsSet("pell.request_timeout") {
+		pellConfig.RequestTimeout = defaultConfig.RequestTimeout
+	}
 
 	return &pellConfig, nil
 }
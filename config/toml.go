@@ -416,9 +416,34 @@ namespace = "{{ .Instrumentation.Namespace }}"
 # Pell Configuration
 [pell]
 
-# Aggregator RPC URL
+# Aggregator RPC URL, prefix it with grpc:// (e.g. grpc://127.0.0.1:26654)
+# to talk to the aggregator over gRPC instead of net/rpc.
+# Several aggregators can be given as a comma separated list, e.g.
+# "127.0.0.1:26653,grpc://10.0.0.2:26654"
 aggregator_rpc_url = "{{ .Pell.AggregatorRPCURL }}"
 
+# How operator responses are submitted when several aggregators are configured:
+#   1) "failover" (default) - submit to one aggregator at a time, trying the
+#      next one when it fails. Aggregators that failed recently are tried last.
+#   2) "broadcast" - submit to all aggregators at once and use the first
+#      validated response.
+aggregator_strategy = "{{ .Pell.AggregatorStrategy }}"
+
+# Where operator responses are aggregated:
+#   1) "remote" (default) - by the aggregators at aggregator_rpc_url, started
+#      separately with start-aggregator.
+#   2) "embedded" - by an aggregator running inside the node, reading the chain
+#      with the node's DVS reader. Meant for single operator and small DVSs.
+aggregator_mode = "{{ .Pell.AggregatorMode }}"
+
+# Path to the JSON config of the embedded aggregator, same format as the
+# start-aggregator config. Defaults are used when empty.
+aggregator_config_path = "{{ .Pell.AggregatorConfigPath }}"
+
+# If true, the embedded aggregator also listens on the RPC and gRPC addresses
+# of its config so that other operators can submit their responses to it.
+embedded_aggregator_serve = {{ .Pell.EmbeddedAggregatorServe }}
+
 # path to the file containing the private key for the operator ECDSA key
 operator_ecdsa_private_key_store_path = "{{ .Pell.OperatorECDSAPrivateKeyStorePath }}"
 
@@ -427,4 +452,54 @@ operator_bls_private_key_store_path = "{{ .Pell.OperatorBLSPrivateKeyStorePath }
 
 # Chain config path
 interactor_config_path = "{{ .Pell.InteractorConfigPath }}"
+
+# Groups the operator is expected to be a member of. On start, the node warns
+# when the operator has no stake in them at the latest block of the accepted
+# chains. When empty, the node only checks that the operator is registered.
+operator_group_numbers = [{{ range .Pell.OperatorGroupNumbers }}{{ printf "%d, " . }}{{end}}]
+
+# Maximum number of blocks a DVS request height may be behind the latest block
+# of its chain, about a day of Ethereum blocks by default. 0 means no limit.
+# Requests for heights past the latest block are always rejected.
+request_max_age_blocks = {{ .Pell.RequestMaxAgeBlocks }}
+
+# Chain IDs DVS requests are accepted for. When empty, the chains of the
+# interactor config are accepted.
+request_allowed_chains = [{{ range .Pell.RequestAllowedChains }}{{ printf "%d, " . }}{{end}}]
+
+# Maximum size in bytes of the data of a DVS request. 0 means no limit.
+request_max_data_size = {{ .Pell.RequestMaxDataSize }}
+
+# Number of times a DVS request left unfinished by a restart is retried when
+# the node starts again, before it is marked as failed. 0 disables resuming.
+request_resume_max_attempts = {{ .Pell.RequestResumeMaxAttempts }}
+
+# Delay before the first retry of a resumed request. It doubles on every
+# further retry.
+request_resume_backoff = "{{ .Pell.RequestResumeBackoff }}"
+
+# Number of DVS requests, and of aggregated responses, the node handles at
+# the same time. Further ones wait in a queue.
+event_workers = {{ .Pell.EventWorkers }}
+
+# Number of events queued for each of their subscribers while it is busy.
+# Further events are dropped, and counted in the dropped_events metric.
+event_queue_size = {{ .Pell.EventQueueSize }}
+
+# Number of requests accepted by request_dvs_async that may wait for a worker.
+# Further requests are refused as busy until the queue has room again. With 0,
+# requests are refused whenever every worker is busy.
+request_queue_size = {{ .Pell.RequestQueueSize }}
+
+# Number of requests accepted by request_dvs_async handled at the same time.
+request_workers = {{ .Pell.RequestWorkers }}
+
+# Maximum time the node waits on shutdown for the queued requests to be
+# handled. The requests still queued or being handled after it are cancelled.
+request_drain_timeout = "{{ .Pell.RequestDrainTimeout }}"
+
+# Maximum time a DVS request may take, from the moment the node starts
+# handling it until its aggregated response is delivered to the application.
+# Requests running out of time are marked as cancelled. 0 means no limit.
+request_timeout = "{{ .Pell.RequestTimeout }}"
 `
@@ -2,18 +2,21 @@ package batch
 
 import (
 	"github.com/0xPellNetwork/pelldvs/crypto"
+	"github.com/0xPellNetwork/pelldvs/crypto/bls"
 	"github.com/0xPellNetwork/pelldvs/crypto/ed25519"
 	"github.com/0xPellNetwork/pelldvs/crypto/sr25519"
 )
 
 // CreateBatchVerifier checks if a key type implements the batch verifier interface.
-// Currently only ed25519 & sr25519 supports batch verification.
+// Currently only ed25519, sr25519 & bls (bn254) support batch verification.
 func CreateBatchVerifier(pk crypto.PubKey) (crypto.BatchVerifier, bool) {
 	switch pk.Type() {
 	case ed25519.KeyType:
 		return ed25519.NewBatchVerifier(), true
 	case sr25519.KeyType:
 		return sr25519.NewBatchVerifier(), true
+	case bls.KeyType:
+		return bls.NewBatchVerifier(), true
 	}
 
 	// case where the key does not support batch verification
@@ -27,7 +30,7 @@ func SupportsBatchVerifier(pk crypto.PubKey) bool {
 		return false
 	}
 	switch pk.Type() {
-	case ed25519.KeyType, sr25519.KeyType:
+	case ed25519.KeyType, sr25519.KeyType, bls.KeyType:
 		return true
 	}
 
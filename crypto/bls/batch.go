@@ -0,0 +1,77 @@
+package bls
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+
+	"github.com/0xPellNetwork/pelldvs/crypto"
+	bn254utils "github.com/0xPellNetwork/pelldvs/crypto/bn254"
+)
+
+const (
+	// MessageSize is the size of the digest a BLS signature is made over
+	MessageSize = 32
+	// SignatureSize is the size of a serialized BLS signature, a G1 point
+	SignatureSize = 64
+)
+
+var _ crypto.BatchVerifier = &BatchVerifier{}
+
+// BatchVerifier implements randomized batch verification for BLS signatures
+// on bn254. Signatures are on G1 and verified against the G2 public key.
+type BatchVerifier struct {
+	sigs    []*bn254.G1Affine
+	pubkeys []*bn254.G2Affine
+	msgs    [][32]byte
+}
+
+func NewBatchVerifier() crypto.BatchVerifier {
+	return &BatchVerifier{}
+}
+
+// Add appends a signature over a 32 bytes digest to the batch. Signatures
+// that are not points of the G1 subgroup are rejected.
+func (b *BatchVerifier) Add(key crypto.PubKey, msg, signature []byte) error {
+	pkBls, ok := key.(PubKey)
+	if !ok {
+		return fmt.Errorf("pubkey is not BLS")
+	}
+	if pkBls.G2Point.G2Affine == nil {
+		return errors.New("pubkey has no G2 point")
+	}
+
+	if l := len(msg); l != MessageSize {
+		return fmt.Errorf("message size is incorrect; expected: %d, got %d", MessageSize, l)
+	}
+	if len(signature) != SignatureSize {
+		return errors.New("invalid signature")
+	}
+	sig, err := bn254utils.DeserializeG1Checked(signature)
+	if err != nil {
+		return fmt.Errorf("invalid signature: %w", err)
+	}
+
+	b.sigs = append(b.sigs, sig)
+	b.pubkeys = append(b.pubkeys, pkBls.G2Point.G2Affine)
+	b.msgs = append(b.msgs, [32]byte(msg))
+	return nil
+}
+
+// Verify checks the whole batch with a single multi-pairing. When the batch
+// fails, every signature is verified on its own to tell which are invalid.
+func (b *BatchVerifier) Verify() (bool, []bool) {
+	valid := make([]bool, len(b.sigs))
+	if ok, err := bn254utils.VerifySigs(b.sigs, b.pubkeys, b.msgs); err == nil && ok {
+		for i := range valid {
+			valid[i] = true
+		}
+		return true, valid
+	}
+
+	for i := range b.sigs {
+		valid[i], _ = bn254utils.VerifySig(b.sigs[i], b.pubkeys[i], b.msgs[i])
+	}
+	return false, valid
+}
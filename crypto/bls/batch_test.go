@@ -0,0 +1,56 @@
+package bls
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBatchVerifier(t *testing.T) {
+	const count = 4
+	pubkeys := make([]PubKey, 0, count)
+	msgs := make([][32]byte, 0, count)
+	sigs := make([][]byte, 0, count)
+	for i := 0; i < count; i++ {
+		keyPair, err := GenRandomBlsKeys()
+		require.NoError(t, err)
+		msg := [32]byte{byte(i)}
+		pubkeys = append(pubkeys, PubKey{G1Point: *keyPair.GetPubKeyG1(), G2Point: *keyPair.GetPubKeyG2()})
+		msgs = append(msgs, msg)
+		sigs = append(sigs, keyPair.SignMessage(msg).Serialize())
+	}
+
+	newVerifier := func(sigs [][]byte) *BatchVerifier {
+		verifier := NewBatchVerifier()
+		for i := range sigs {
+			require.NoError(t, verifier.Add(pubkeys[i], msgs[i][:], sigs[i]))
+		}
+		return verifier.(*BatchVerifier)
+	}
+
+	ok, valid := newVerifier(sigs).Verify()
+	require.True(t, ok)
+	require.Equal(t, []bool{true, true, true, true}, valid)
+
+	// two signatures swapped between messages are both reported invalid
+	swapped := append([][]byte{}, sigs...)
+	swapped[1], swapped[2] = sigs[2], sigs[1]
+	ok, valid = newVerifier(swapped).Verify()
+	require.False(t, ok)
+	require.Equal(t, []bool{true, false, false, true}, valid)
+
+	verifier := NewBatchVerifier()
+	require.Error(t, verifier.Add(pubkeys[0], msgs[0][:16], sigs[0]))
+	require.Error(t, verifier.Add(pubkeys[0], msgs[0][:], sigs[0][:32]))
+
+	// signatures that are not points of G1 are rejected
+	offCurve := append([]byte{}, sigs[0]...)
+	offCurve[63] ^= 1
+	require.ErrorContains(t, verifier.Add(pubkeys[0], msgs[0][:], offCurve), "not in the G1 subgroup")
+	require.ErrorContains(t, verifier.Add(pubkeys[0], msgs[0][:], make([]byte, SignatureSize)), "point at infinity")
+	unreduced := append([]byte{}, sigs[0]...)
+	for i := 0; i < 32; i++ {
+		unreduced[i] = 0xff
+	}
+	require.ErrorContains(t, verifier.Add(pubkeys[0], msgs[0][:], unreduced), "X coordinate")
+}
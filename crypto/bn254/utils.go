@@ -1,6 +1,8 @@
 package bn254
 
 import (
+	"errors"
+	"fmt"
 	"math/big"
 
 	"github.com/consensys/gnark-crypto/ecc/bn254"
@@ -28,6 +30,50 @@ func VerifySig(sig *bn254.G1Affine, pubkey *bn254.G2Affine, msgBytes [32]byte) (
 
 }
 
+// VerifySigs verifies several signatures at once, each against its own
+// public key and message. Every signature is weighted by a random scalar so
+// that invalid signatures cannot cancel each other out, and the whole batch
+// is checked with a single multi-pairing. It only reports whether all the
+// signatures are valid.
+func VerifySigs(sigs []*bn254.G1Affine, pubkeys []*bn254.G2Affine, msgs [][32]byte) (bool, error) {
+	if len(sigs) != len(pubkeys) || len(sigs) != len(msgs) {
+		return false, fmt.Errorf("mismatched batch sizes: %d signatures, %d public keys, %d messages",
+			len(sigs), len(pubkeys), len(msgs))
+	}
+	if len(sigs) == 0 {
+		return true, nil
+	}
+
+	P := make([]bn254.G1Affine, 0, len(sigs)+1)
+	Q := make([]bn254.G2Affine, 0, len(sigs)+1)
+
+	var aggSig bn254.G1Jac
+	for i := range sigs {
+		var r fr.Element
+		if _, err := r.SetRandom(); err != nil {
+			return false, fmt.Errorf("failed to draw batch scalar: %w", err)
+		}
+		scalar := r.BigInt(new(big.Int))
+
+		var weightedSig bn254.G1Affine
+		weightedSig.ScalarMultiplication(sigs[i], scalar)
+		aggSig.AddMixed(&weightedSig)
+
+		var weightedMsg bn254.G1Affine
+		weightedMsg.ScalarMultiplication(MapToCurve(msgs[i]), scalar)
+		P = append(P, weightedMsg)
+		Q = append(Q, *pubkeys[i])
+	}
+
+	var negAggSig bn254.G1Affine
+	negAggSig.FromJacobian(&aggSig)
+	negAggSig.Neg(&negAggSig)
+	P = append(P, negAggSig)
+	Q = append(Q, *GetG2Generator())
+
+	return bn254.PairingCheck(P, Q)
+}
+
 // MapToCurve implements the simple hash-and-check (also sometimes try-and-increment) algorithm
 // see https://hackmd.io/@benjaminion/bls12-381#Hash-and-check
 // Note that this function needs to be the same as the one used in the contract:
@@ -115,6 +161,30 @@ func DeserializeG1(b []byte) *bn254.G1Affine {
 	return p
 }
 
+// DeserializeG1Checked deserializes a G1 point received from an untrusted
+// source. Unlike DeserializeG1, it rejects coordinates that are not reduced
+// field elements, points off the curve or outside the G1 subgroup, and the
+// point at infinity.
+func DeserializeG1Checked(b []byte) (*bn254.G1Affine, error) {
+	if len(b) != 64 {
+		return nil, fmt.Errorf("G1 point size is incorrect; expected: 64, got %d", len(b))
+	}
+	p := new(bn254.G1Affine)
+	if err := p.X.SetBytesCanonical(b[0:32]); err != nil {
+		return nil, fmt.Errorf("invalid G1 point X coordinate: %w", err)
+	}
+	if err := p.Y.SetBytesCanonical(b[32:64]); err != nil {
+		return nil, fmt.Errorf("invalid G1 point Y coordinate: %w", err)
+	}
+	if p.IsInfinity() {
+		return nil, errors.New("G1 point is the point at infinity")
+	}
+	if !p.IsInSubGroup() {
+		return nil, errors.New("G1 point is not in the G1 subgroup")
+	}
+	return p, nil
+}
+
 func SerializeG2(p *bn254.G2Affine) []byte {
 	b := make([]byte, 0)
 	tmp := p.X.A0.Bytes()
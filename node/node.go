@@ -14,6 +14,7 @@ import (
 
 	"github.com/0xPellNetwork/pelldvs-interactor/interactor/reader"
 	"github.com/0xPellNetwork/pelldvs-libs/log"
+	aggrpc "github.com/0xPellNetwork/pelldvs/aggregator/rpc"
 	aggtypes "github.com/0xPellNetwork/pelldvs/aggregator/types"
 	cfg "github.com/0xPellNetwork/pelldvs/config"
 	"github.com/0xPellNetwork/pelldvs/libs/service"
@@ -52,6 +53,12 @@ type Node struct {
 	proxyApp          proxy.AppConns // connection to the application
 	dvsReactor        security.DVSReactor
 	aggregatorReactor *security.AggregatorReactor
+	requestResumer    *security.RequestResumer
+	eventManager      *security.EventManager
+	requestQueue      *security.RequestQueue
+	stopResume        context.CancelFunc
+
+	embeddedAggregator *aggrpc.AggregatorRPCServer // set in embedded aggregator mode
 
 	rpcListeners []net.Listener // rpc servers
 	pexReactor   *pex.Reactor   // for exchanging peer addresses
@@ -108,6 +115,8 @@ func CustomReactors(reactors map[string]p2p.Reactor) Option {
 //------------------------------------------------------------------------------
 
 // NewNode returns a new, ready to go, PellDVS Node.
+// In embedded aggregator mode, the given aggregator is replaced by one
+// running in the node and reading the chain with dvsReader.
 func NewNode(config *cfg.Config,
 	privValidator types.PrivValidator,
 	nodeKey *p2p.NodeKey,
@@ -138,7 +147,7 @@ func NewNodeWithContext(ctx context.Context,
 	options ...Option,
 ) (*Node, error) {
 	// TODO: add service id from config
-	p2pMetrics, avsiMetrics := metricsProvider("id")
+	p2pMetrics, avsiMetrics, securityMetrics := metricsProvider("id")
 
 	// Create the proxyApp and establish connections to the AVSI app (consensus, mempool, query).
 	proxyApp, err := createAndStartProxyAppConns(clientCreator, logger, avsiMetrics)
@@ -237,14 +246,31 @@ func NewNodeWithContext(ctx context.Context,
 	}
 
 	// Create the event manager
-	eventManager := security.NewEventManager(logger)
+	eventManager := security.NewEventManager(logger,
+		security.WithEventWorkers(config.Pell.EventWorkers),
+		security.WithEventQueueSize(config.Pell.EventQueueSize),
+		security.WithEventMetrics(securityMetrics),
+	)
+
+	requestPolicy, err := createRequestPolicy(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request policy: %w", err)
+	}
 
 	// Create the DVS and Aggregator reactors
 	dvsReactor, err := security.CreateDVSReactor(*config.Pell,
-		proxyApp, dvsRequestIndexer, dvsReader, dvsState, logger, eventManager)
+		proxyApp, dvsRequestIndexer, dvsReader, dvsState, logger, eventManager, requestPolicy)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create dvsReactor: %w", err)
 	}
+	var embeddedAggregator *aggrpc.AggregatorRPCServer
+	if config.Pell.AggregatorMode == cfg.AggregatorModeEmbedded {
+		embeddedAggregator, err = createEmbeddedAggregator(ctx, config, dvsReader, logger)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create embedded aggregator: %w", err)
+		}
+		aggregator = aggrpc.NewLocalAggregator(embeddedAggregator)
+	}
 	aggregatorReactor := security.CreateAggregatorReactor(aggregator, dvsRequestIndexer,
 		privValidator, dvsState, logger, eventManager)
 
@@ -252,6 +278,12 @@ func NewNodeWithContext(ctx context.Context,
 	eventManager.SetAggregatorReactor(aggregatorReactor)
 	eventManager.StartListening()
 
+	requestQueue := security.NewRequestQueue(dvsReactor.SubmitAdmittedDVSRequest, config.Pell.RequestQueueSize,
+		config.Pell.RequestWorkers, securityMetrics, logger.With("module", "request-queue"))
+
+	requestResumer := security.NewRequestResumer(*config.Pell, &dvsReactor, aggregatorReactor,
+		dvsRequestIndexer, logger)
+
 	node := &Node{
 		config:            config,
 		transport:         transport,
@@ -264,6 +296,11 @@ func NewNodeWithContext(ctx context.Context,
 		dvsRequestIndexer: dvsRequestIndexer,
 		dvsReactor:        dvsReactor,
 		aggregatorReactor: aggregatorReactor,
+		requestResumer:    requestResumer,
+		eventManager:      eventManager,
+		requestQueue:      requestQueue,
+
+		embeddedAggregator: embeddedAggregator,
 	}
 	node.BaseService = *service.NewBaseService(logger, "Node", node)
 
@@ -287,6 +324,26 @@ func (n *Node) OnStart() error {
 		n.prometheusSrv = n.startPrometheusServer()
 	}
 
+	// Start the embedded aggregator before the node can receive requests
+	if n.embeddedAggregator != nil {
+		if err := n.embeddedAggregator.Start(); err != nil {
+			return fmt.Errorf("failed to start embedded aggregator: %w", err)
+		}
+	}
+
+	// An operator that is not registered handles no request, which is most
+	// likely a misconfiguration, but it may still register later on
+	if err := n.dvsReactor.CheckOperatorRegistration(context.Background()); err != nil {
+		n.Logger.Warn("Could not confirm the operator is a member of its groups", "err", err)
+	}
+
+	// Look the unfinished requests up before the node accepts new ones,
+	// which would otherwise be resumed along with them
+	unfinished := n.requestResumer.Unfinished(context.Background())
+
+	// Start handling requests before the RPC server accepts them
+	n.requestQueue.Start()
+
 	// Start the RPC server before the P2P server
 	// so we can eg. receive txs for the first block
 	if n.config.RPC.ListenAddress != "" {
@@ -320,6 +377,12 @@ func (n *Node) OnStart() error {
 		return fmt.Errorf("could not dial peers from persistent_peers field: %w", err)
 	}
 
+	// Resume the requests left unfinished by the last run once the
+	// aggregator and the application can be reached
+	var resumeCtx context.Context
+	resumeCtx, n.stopResume = context.WithCancel(context.Background())
+	go n.requestResumer.Run(resumeCtx, unfinished)
+
 	return nil
 }
 
@@ -329,6 +392,10 @@ func (n *Node) OnStop() {
 
 	n.Logger.Info("Stopping Node")
 
+	if n.stopResume != nil {
+		n.stopResume()
+	}
+
 	// // first stop the non-reactor services
 	// if err := n.eventBus.Stop(); err != nil {
 	// 	n.Logger.Error("Error closing eventBus", "err", err)
@@ -351,6 +418,21 @@ func (n *Node) OnStop() {
 
 	n.isListening = false
 
+	// drain the queued requests while the aggregator can still answer them
+	if err := n.requestQueue.Stop(n.config.Pell.RequestDrainTimeout); err != nil {
+		n.Logger.Error("Error draining request queue", "err", err)
+	}
+
+	// stop the embedded aggregator, answering the responses it still holds
+	if n.embeddedAggregator != nil && n.embeddedAggregator.IsRunning() {
+		if err := n.embeddedAggregator.Stop(); err != nil {
+			n.Logger.Error("Error stopping embedded aggregator", "err", err)
+		}
+	}
+
+	// stop dispatching reactor events, once the aggregator has answered
+	n.eventManager.Stop()
+
 	// finally stop the listeners / external services
 	for _, l := range n.rpcListeners {
 		n.Logger.Info("Closing rpc listener", "listener", l)
@@ -378,6 +460,7 @@ func (n *Node) ConfigureRPC() (*rpccore.Environment, error) {
 	rpcCoreEnv := rpccore.Environment{
 		ProxyAppQuery: n.proxyApp.Query(),
 		DVSReactor:    n.dvsReactor,
+		RequestQueue:  n.requestQueue,
 		P2PPeers:      n.sw,
 		P2PTransport:  n,
 
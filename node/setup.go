@@ -11,14 +11,19 @@ import (
 	_ "github.com/lib/pq" // provide the psql db driver
 
 	interactorcfg "github.com/0xPellNetwork/pelldvs-interactor/config"
+	"github.com/0xPellNetwork/pelldvs-interactor/interactor/reader"
 	"github.com/0xPellNetwork/pelldvs-libs/log"
+	aggclient "github.com/0xPellNetwork/pelldvs/aggregator/client"
+	aggcfg "github.com/0xPellNetwork/pelldvs/aggregator/config"
 	aggrpc "github.com/0xPellNetwork/pelldvs/aggregator/rpc"
+	aggtypes "github.com/0xPellNetwork/pelldvs/aggregator/types"
 	avsi "github.com/0xPellNetwork/pelldvs/avsi/types"
 	cfg "github.com/0xPellNetwork/pelldvs/config"
 	"github.com/0xPellNetwork/pelldvs/p2p"
 	"github.com/0xPellNetwork/pelldvs/p2p/pex"
 	"github.com/0xPellNetwork/pelldvs/privval"
 	"github.com/0xPellNetwork/pelldvs/proxy"
+	"github.com/0xPellNetwork/pelldvs/security"
 	"github.com/0xPellNetwork/pelldvs/state/requestindex"
 	"github.com/0xPellNetwork/pelldvs/state/requestindex/kv"
 	"github.com/0xPellNetwork/pelldvs/state/requestindex/null"
@@ -39,9 +44,13 @@ func DefaultNewNode(config *cfg.Config, logger log.Logger) (*Node, error) {
 		return nil, fmt.Errorf("failed to load or gen node key %s: %w", config.NodeKeyFile(), err)
 	}
 
-	aggregator, err := aggrpc.NewAggregatorRPCClient(config.Pell.AggregatorRPCURL, logger)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create RPCAggregator")
+	// in embedded mode the node builds its own aggregator
+	var aggregator aggtypes.Aggregator
+	if config.Pell.AggregatorMode != cfg.AggregatorModeEmbedded {
+		aggregator, err = aggclient.NewAggregatorClient(config.Pell.AggregatorRPCURL, config.Pell.AggregatorStrategy, logger)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create RPCAggregator: %v", err)
+		}
 	}
 
 	pv, err := privval.LoadOrGenFilePV(config.Pell.OperatorBLSPrivateKeyStorePath)
@@ -81,20 +90,62 @@ func DefaultNewNode(config *cfg.Config, logger log.Logger) (*Node, error) {
 	)
 }
 
-// MetricsProvider returns a consensus, p2p and mempool Metrics.
-type MetricsProvider func(chainID string) (*p2p.Metrics, *proxy.Metrics)
+// MetricsProvider returns a p2p, proxy and security Metrics.
+type MetricsProvider func(chainID string) (*p2p.Metrics, *proxy.Metrics, *security.Metrics)
 
 // DefaultMetricsProvider returns Metrics build using Prometheus client library
 // if Prometheus is enabled. Otherwise, it returns no-op Metrics.
 func DefaultMetricsProvider(config *cfg.InstrumentationConfig) MetricsProvider {
-	return func(chainID string) (*p2p.Metrics, *proxy.Metrics) {
+	return func(chainID string) (*p2p.Metrics, *proxy.Metrics, *security.Metrics) {
 		if config.Prometheus {
 			return p2p.PrometheusMetrics(config.Namespace, "chain_id", chainID),
-				proxy.PrometheusMetrics(config.Namespace, "chain_id", chainID)
+				proxy.PrometheusMetrics(config.Namespace, "chain_id", chainID),
+				security.PrometheusMetrics(config.Namespace, "chain_id", chainID)
 		}
 		// _ = mempl.NopMetrics()
-		return p2p.NopMetrics(), proxy.NopMetrics()
+		return p2p.NopMetrics(), proxy.NopMetrics(), security.NopMetrics()
+	}
+}
+
+// createEmbeddedAggregator creates the aggregator of a node in embedded
+// aggregator mode, sharing the DVS reader of the node. It only listens for
+// the responses of other operators when embedded_aggregator_serve is set.
+func createEmbeddedAggregator(ctx context.Context, config *cfg.Config,
+	dvsReader reader.DVSReader, logger log.Logger) (*aggrpc.AggregatorRPCServer, error) {
+	aggConfig := &aggcfg.AggregatorConfig{}
+	if config.Pell.AggregatorConfigPath != "" {
+		var err error
+		aggConfig, err = aggcfg.LoadConfig(config.Pell.AggregatorConfigPath, logger)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load aggregator config: %v", err)
+		}
+	} else {
+		aggConfig.Finalize(logger)
+	}
+	if !config.Pell.EmbeddedAggregatorServe {
+		aggConfig.AggregatorRPCServer = ""
+		aggConfig.AggregatorGRPCServer = ""
+	}
+
+	interactorConfig, err := interactorcfg.LoadConfig(config.Pell.InteractorConfigPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create interactor config from file: %v", err)
 	}
+
+	return aggrpc.NewAggregatorGRPCServer(ctx, aggConfig, interactorConfig, config, dvsReader, logger)
+}
+
+// createRequestPolicy creates the policy the DVS reactor admits requests
+// with. Unless restricted by the config, the chains of the interactor config
+// are accepted, and request heights are checked against their latest block.
+func createRequestPolicy(config *cfg.Config) (*security.RequestPolicy, error) {
+	interactorConfig, err := interactorcfg.LoadConfig(config.Pell.InteractorConfigPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create interactor config from file: %v", err)
+	}
+
+	heights := utils.NewChainHeightReader(interactorConfig, utils.DefaultChainHeightTTL)
+	return security.NewRequestPolicy(*config.Pell, heights.ChainIDs(), heights), nil
 }
 
 func createAndStartProxyAppConns(clientCreator proxy.ClientCreator,
@@ -209,6 +209,7 @@ func (c *baseRPCClient) RequestDVS(
 	chainid int64,
 	groupNumbers []uint32,
 	groupThresholdPercentages []uint32,
+	retryFailed bool,
 ) (*ctypes.ResultRequest, error) {
 	result := new(ctypes.ResultRequest)
 	_, err := c.caller.Call(ctx, "request_dvs", map[string]interface{}{
@@ -217,6 +218,7 @@ func (c *baseRPCClient) RequestDVS(
 		"chainid":                     chainid,
 		"group_numbers":               groupNumbers,
 		"group_threshold_percentages": groupThresholdPercentages,
+		"retry_failed":                retryFailed,
 	}, result)
 	if err != nil {
 		return nil, err
@@ -231,6 +233,7 @@ func (c *baseRPCClient) RequestDVSAsync(
 	chainid int64,
 	groupNumbers []uint32,
 	groupThresholdPercentages []uint32,
+	retryFailed bool,
 ) (*ctypes.ResultRequestDvsAsync, error) {
 	result := new(ctypes.ResultRequestDvsAsync)
 	_, err := c.caller.Call(ctx, "request_dvs_async", map[string]interface{}{
@@ -239,6 +242,7 @@ func (c *baseRPCClient) RequestDVSAsync(
 		"chainid":                     chainid,
 		"group_numbers":               groupNumbers,
 		"group_threshold_percentages": groupThresholdPercentages,
+		"retry_failed":                retryFailed,
 	}, result)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,50 @@
+package http_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	rpchttp "github.com/0xPellNetwork/pelldvs/rpc/client/http"
+)
+
+// recordingServer answers every JSON-RPC call with an empty result and
+// records the params of the calls
+func recordingServer(t *testing.T) (*httptest.Server, *[]map[string]json.RawMessage) {
+	var params []map[string]json.RawMessage
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     json.RawMessage            `json:"id"`
+			Params map[string]json.RawMessage `json:"params"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		params = append(params, req.Params)
+		fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"result":{}}`, req.ID)
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &params
+}
+
+func TestRequestDVSRetryFailed(t *testing.T) {
+	srv, params := recordingServer(t)
+	c, err := rpchttp.New(srv.URL, "/websocket")
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	for _, retryFailed := range []bool{false, true} {
+		_, err = c.RequestDVS(ctx, []byte("data"), 10, 1, []uint32{1}, []uint32{67}, retryFailed)
+		require.NoError(t, err)
+		_, err = c.RequestDVSAsync(ctx, []byte("data"), 10, 1, []uint32{1}, []uint32{67}, retryFailed)
+		require.NoError(t, err)
+	}
+
+	require.Len(t, *params, 4)
+	for i, expected := range []string{"false", "false", "true", "true"} {
+		require.JSONEq(t, expected, string((*params)[i]["retry_failed"]))
+	}
+}
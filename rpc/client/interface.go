@@ -68,6 +68,8 @@ type EventsClient interface {
 
 // DVSClient
 type DVSClient interface {
+	// RequestDVS submits a DVS request, handling it again from the start if
+	// it failed or was cancelled and retryFailed is set
 	RequestDVS(
 		ctx context.Context,
 		data []byte,
@@ -75,8 +77,11 @@ type DVSClient interface {
 		chainid int64,
 		groupNumbers []uint32,
 		groupThresholdPercentages []uint32,
+		retryFailed bool,
 	) (*ctypes.ResultRequest, error)
 
+	// RequestDVSAsync queues a DVS request, to be handled again from the
+	// start if it failed or was cancelled and retryFailed is set
 	RequestDVSAsync(
 		ctx context.Context,
 		data []byte,
@@ -84,6 +89,7 @@ type DVSClient interface {
 		chainid int64,
 		groupNumbers []uint32,
 		groupThresholdPercentages []uint32,
+		retryFailed bool,
 	) (*ctypes.ResultRequestDvsAsync, error)
 
 	QueryRequest(ctx context.Context, hash string) (*ctypes.ResultDvsRequest, error)
@@ -95,8 +95,9 @@ func (c *Local) RequestDVS(
 	chainid int64,
 	groupNumbers []uint32,
 	groupThresholdPercentages []uint32,
+	retryFailed bool,
 ) (*ctypes.ResultRequest, error) {
-	return c.env.RequestDVS(c.ctx, data, height, chainid, groupNumbers, groupThresholdPercentages)
+	return c.env.RequestDVS(c.ctx, data, height, chainid, groupNumbers, groupThresholdPercentages, retryFailed)
 }
 
 func (c *Local) RequestDVSAsync(
@@ -106,8 +107,9 @@ func (c *Local) RequestDVSAsync(
 	chainid int64,
 	groupNumbers []uint32,
 	groupThresholdPercentages []uint32,
+	retryFailed bool,
 ) (*ctypes.ResultRequestDvsAsync, error) {
-	return c.env.RequestDVSAsync(c.ctx, data, height, chainid, groupNumbers, groupThresholdPercentages)
+	return c.env.RequestDVSAsync(c.ctx, data, height, chainid, groupNumbers, groupThresholdPercentages, retryFailed)
 }
 
 func (c *Local) QueryRequest(hash string) (*ctypes.ResultDvsRequest, error) {
@@ -11,15 +11,22 @@ import (
 	cmtquery "github.com/0xPellNetwork/pelldvs/libs/query"
 	ctypes "github.com/0xPellNetwork/pelldvs/rpc/core/types"
 	rpctypes "github.com/0xPellNetwork/pelldvs/rpc/jsonrpc/types"
+	"github.com/0xPellNetwork/pelldvs/security"
 	"github.com/0xPellNetwork/pelldvs/state/requestindex/null"
 )
 
+// RequestDVS handles the DVS request and returns once it is submitted for
+// aggregation. A request that was already submitted is not handled again:
+// its current state is returned instead, unless it failed or was cancelled
+// and retryFailed is set, in which case it is handled again from the start.
+// The request is cancelled when the client goes away before it returns.
 func (env *Environment) RequestDVS(ctx *rpctypes.Context,
 	data []byte,
 	height int64,
 	chainid int64,
 	groupNumbers []uint32,
 	groupThresholdPercentages []uint32,
+	retryFailed bool,
 ) (*ctypes.ResultRequest, error) {
 	request := avsitypes.DVSRequest{
 		Data:                      data,
@@ -28,21 +35,40 @@ func (env *Environment) RequestDVS(ctx *rpctypes.Context,
 		GroupNumbers:              groupNumbers,
 		GroupThresholdPercentages: groupThresholdPercentages,
 	}
+	hash := bytes.HexBytes(request.Hash())
 
-	err := env.DVSReactor.HandleDVSRequest(request)
+	existing, retry, err := env.existingDVSRequest(request, retryFailed)
+	if err != nil {
+		return &ctypes.ResultRequest{}, err
+	}
+	if existing != nil && !retry {
+		return &ctypes.ResultRequest{Hash: hash, Existing: resultDvsRequest(existing)}, nil
+	}
+
+	err = env.DVSReactor.SubmitDVSRequest(ctx.Context(), request, retry)
+	if errors.Is(err, security.ErrDVSRequestExists) {
+		// submitted by a concurrent call since it was looked up
+		if existing, err = env.DvsRequestIndexer.Get(hash); err == nil && existing != nil {
+			return &ctypes.ResultRequest{Hash: hash, Existing: resultDvsRequest(existing)}, nil
+		}
+	}
 	if err != nil {
 		return &ctypes.ResultRequest{}, err
 	}
 
-	return &ctypes.ResultRequest{}, nil
+	return &ctypes.ResultRequest{Hash: hash}, nil
 }
 
+// RequestDVSAsync queues the DVS request and returns its hash. Like
+// RequestDVS, it returns the current state of a request that was already
+// submitted, unless it failed or was cancelled and retryFailed is set.
 func (env *Environment) RequestDVSAsync(ctx *rpctypes.Context,
 	data []byte,
 	height int64,
 	chainID int64,
 	groupNumbers []uint32,
 	groupThresholdPercentages []uint32,
+	retryFailed bool,
 ) (*ctypes.ResultRequestDvsAsync, error) {
 	request := avsitypes.DVSRequest{
 		Data:                      data,
@@ -51,17 +77,43 @@ func (env *Environment) RequestDVSAsync(ctx *rpctypes.Context,
 		GroupNumbers:              groupNumbers,
 		GroupThresholdPercentages: groupThresholdPercentages,
 	}
-	go func() {
-		if err := env.DVSReactor.HandleDVSRequest(request); err != nil {
-			env.Logger.Error("RequestDvsAsync", "module", "rpc", "func", "HandleDVSRequest", "err", err)
-		}
-	}()
+	hash := bytes.HexBytes(request.Hash())
+
+	existing, retry, err := env.existingDVSRequest(request, retryFailed)
+	if err != nil {
+		return &ctypes.ResultRequestDvsAsync{}, err
+	}
+	if existing != nil && !retry {
+		return &ctypes.ResultRequestDvsAsync{Hash: hash, Existing: resultDvsRequest(existing)}, nil
+	}
+
+	if err := env.DVSReactor.ValidateDVSRequest(request); err != nil {
+		return &ctypes.ResultRequestDvsAsync{}, err
+	}
+	if err := env.RequestQueue.Enqueue(request, retry); err != nil {
+		return &ctypes.ResultRequestDvsAsync{}, err
+	}
 
 	return &ctypes.ResultRequestDvsAsync{
-		Hash: bytes.HexBytes(request.Hash()),
+		Hash: hash,
 	}, nil
 }
 
+// existingDVSRequest returns the indexed result of the request, if any, and
+// whether the request is to be retried
+func (env *Environment) existingDVSRequest(request avsitypes.DVSRequest,
+	retryFailed bool) (*avsitypes.DVSRequestResult, bool, error) {
+	existing, err := env.DvsRequestIndexer.Get(request.Hash())
+	if err != nil {
+		return nil, false, err
+	}
+	if existing == nil {
+		return nil, false, nil
+	}
+	retry := retryFailed && existing.Status.IsRetryable()
+	return existing, retry, nil
+}
+
 // QueryRequest allows you to query for a DVS request result. It returns a
 func (env *Environment) QueryRequest(_ *rpctypes.Context, hash string) (*ctypes.ResultDvsRequest, error) {
 	hashAsBytes, err := hex.DecodeString(hash)
@@ -83,12 +135,7 @@ func (env *Environment) QueryRequest(_ *rpctypes.Context, hash string) (*ctypes.
 		return nil, fmt.Errorf("dvs (%X) not found", hash)
 	}
 
-	return &ctypes.ResultDvsRequest{
-		DvsRequest:                 r.DvsRequest,
-		DvsResponse:                r.DvsResponse,
-		ResponseProcessDvsRequest:  r.ResponseProcessDvsRequest,
-		ResponseProcessDVSResponse: r.ResponseProcessDvsResponse,
-	}, nil
+	return resultDvsRequest(r), nil
 }
 
 // SearchRequest allows you to query for multiple DVS request results. It returns a
@@ -128,18 +175,35 @@ func (env *Environment) SearchRequest(
 
 	apiResults := make([]*ctypes.ResultDvsRequest, 0, pageSize)
 	for i := skipCount; i < skipCount+pageSize; i++ {
-		r := results[i]
-		hash := []byte(r.DvsRequest.Hash())
-
-		apiResults = append(apiResults, &ctypes.ResultDvsRequest{
-			DvsRequest:                 r.DvsRequest,
-			DvsResponse:                r.DvsResponse,
-			ResponseProcessDvsRequest:  r.ResponseProcessDvsRequest,
-			ResponseProcessDVSResponse: r.ResponseProcessDvsResponse,
-			Hash:                       hash,
-		})
+		apiResults = append(apiResults, resultDvsRequest(results[i]))
 	}
 
 	return &ctypes.ResultDvsRequestSearch{DvsRequests: apiResults, TotalCount: totalCount}, nil
 
 }
+
+// resultDvsRequest returns the RPC result of a dvs request result
+func resultDvsRequest(r *avsitypes.DVSRequestResult) *ctypes.ResultDvsRequest {
+	return &ctypes.ResultDvsRequest{
+		DvsRequest:                 r.DvsRequest,
+		DvsResponse:                r.DvsResponse,
+		ResponseProcessDvsRequest:  r.ResponseProcessDvsRequest,
+		ResponseProcessDVSResponse: r.ResponseProcessDvsResponse,
+		Hash:                       bytes.HexBytes(r.DvsRequest.Hash()),
+		Status:                     r.Status.Name(),
+		FailureReason:              r.FailureReason,
+		Transitions:                statusTransitions(r),
+	}
+}
+
+// statusTransitions returns the status transitions of a dvs request result
+func statusTransitions(r *avsitypes.DVSRequestResult) []ctypes.ResultStatusTransition {
+	transitions := make([]ctypes.ResultStatusTransition, 0, len(r.Transitions))
+	for _, transition := range r.Transitions {
+		transitions = append(transitions, ctypes.ResultStatusTransition{
+			Status: transition.Status.Name(),
+			Time:   transition.Time,
+		})
+	}
+	return transitions
+}
@@ -46,6 +46,7 @@ type Environment struct {
 	// external, thread safe interfaces
 	ProxyAppQuery proxy.AppConnQuery
 	DVSReactor    security.DVSReactor
+	RequestQueue  *security.RequestQueue
 	// ProxyAppMempool proxy.AppConnMempool
 
 	P2PPeers     peers
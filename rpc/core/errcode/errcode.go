@@ -2,4 +2,18 @@ package errcode
 
 const (
 	AggregationFailed int = 32000
+	InvalidSignature  int = 32001
+	Unauthenticated   int = 32002
+	TooManyTasks      int = 32003
+	RequestTooLarge   int = 32004
+	TooManyGroups     int = 32005
+	NotGroupMember    int = 32006
+	ShuttingDown      int = 32007
+	BatchTooLarge     int = 32008
+	InvalidRequest    int = 32009
+	ChainNotAllowed   int = 32010
+	RequestTooOld     int = 32011
+	RequestInFuture   int = 32012
+	NodeBusy          int = 32013
+	ChainUnavailable  int = 32014
 )
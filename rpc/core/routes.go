@@ -20,8 +20,8 @@ func (env *Environment) GetRoutes() RoutesMap {
 		"avsi_info":  rpc.NewRPCFunc(env.AVSIInfo, "", rpc.Cacheable()),
 
 		// dvs API
-		"request_dvs":       rpc.NewRPCFunc(env.RequestDVS, "data,height,chainid,group_numbers,group_threshold_percentages"),
-		"request_dvs_async": rpc.NewRPCFunc(env.RequestDVSAsync, "data,height,chainid,group_numbers,group_threshold_percentages"),
+		"request_dvs":       rpc.NewRPCFunc(env.RequestDVS, "data,height,chainid,group_numbers,group_threshold_percentages,retry_failed"),
+		"request_dvs_async": rpc.NewRPCFunc(env.RequestDVSAsync, "data,height,chainid,group_numbers,group_threshold_percentages,retry_failed"),
 		"query_request":     rpc.NewRPCFunc(env.QueryRequest, "hash"),
 		"search_request":    rpc.NewRPCFunc(env.SearchRequest, "query,page,per_page"),
 	}
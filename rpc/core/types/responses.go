@@ -2,6 +2,7 @@ package coretypes
 
 import (
 	"encoding/json"
+	"time"
 
 	avsi "github.com/0xPellNetwork/pelldvs/avsi/types"
 	"github.com/0xPellNetwork/pelldvs/libs/bytes"
@@ -14,6 +15,9 @@ type ResultRequest struct {
 	Data      bytes.HexBytes `json:"data"`
 	Log       string         `json:"log"`
 	Codespace string         `json:"codespace"`
+	Hash      bytes.HexBytes `json:"hash,omitempty"`
+	// Existing is the state of the request when it was already submitted
+	Existing *ResultDvsRequest `json:"existing,omitempty"`
 }
 
 type ResultDvsRequest struct {
@@ -22,6 +26,15 @@ type ResultDvsRequest struct {
 	ResponseProcessDvsRequest  *avsi.ResponseProcessDVSRequest  `json:"response_dvs_request"`
 	ResponseProcessDVSResponse *avsi.ResponseProcessDVSResponse `json:"response_dvs_response"`
 	Hash                       bytes.HexBytes                   `json:"hash,omitempty"`
+	Status                     string                           `json:"status"`
+	FailureReason              string                           `json:"failure_reason,omitempty"`
+	Transitions                []ResultStatusTransition         `json:"transitions"`
+}
+
+// ResultStatusTransition is the time a dvs request reached a status
+type ResultStatusTransition struct {
+	Status string    `json:"status"`
+	Time   time.Time `json:"time"`
 }
 
 // Result of searching for dvs request
@@ -32,6 +45,8 @@ type ResultDvsRequestSearch struct {
 
 type ResultRequestDvsAsync struct {
 	Hash bytes.HexBytes `json:"hash"`
+	// Existing is the state of the request when it was already submitted
+	Existing *ResultDvsRequest `json:"existing,omitempty"`
 }
 
 // Info avsi msg
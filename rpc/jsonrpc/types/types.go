@@ -3,6 +3,7 @@ package types
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"reflect"
@@ -240,7 +241,13 @@ func RPCInvalidParamsError(id jsonrpcid, err error) RPCResponse {
 	return NewRPCErrorResponse(id, -32602, "Invalid params", err.Error())
 }
 
+// RPCInternalError returns an internal error response, unless the error is
+// an RPCError, whose code is then returned as is.
 func RPCInternalError(id jsonrpcid, err error) RPCResponse {
+	var rpcErr *RPCError
+	if errors.As(err, &rpcErr) {
+		return NewRPCErrorResponse(id, rpcErr.Code, rpcErr.Message, rpcErr.Data)
+	}
 	return NewRPCErrorResponse(id, -32603, "Internal error", err.Error())
 }
 
@@ -81,3 +81,11 @@ func TestRPCError(t *testing.T) {
 			Message: "Badness",
 		}))
 }
+
+func TestRPCInternalErrorKeepsRPCErrorCode(t *testing.T) {
+	res := RPCInternalError(JSONRPCIntID(1), fmt.Errorf("wrapped: %w", &RPCError{Code: 32009, Message: "Invalid request"}))
+	assert.Equal(t, &RPCError{Code: 32009, Message: "Invalid request"}, res.Error)
+
+	res = RPCInternalError(JSONRPCIntID(1), errors.New("boom"))
+	assert.Equal(t, &RPCError{Code: -32603, Message: "Internal error", Data: "boom"}, res.Error)
+}
@@ -1,6 +1,8 @@
 package security
 
 import (
+	"context"
+	"errors"
 	"fmt"
 
 	"github.com/0xPellNetwork/pelldvs-libs/crypto/bls"
@@ -9,6 +11,7 @@ import (
 	avsitypes "github.com/0xPellNetwork/pelldvs/avsi/types"
 	"github.com/0xPellNetwork/pelldvs/state/requestindex"
 	"github.com/0xPellNetwork/pelldvs/types"
+	cmttime "github.com/0xPellNetwork/pelldvs/types/time"
 )
 
 // AggregatorReactor handles the collection and aggregation of response signatures
@@ -65,34 +68,24 @@ func (ar *AggregatorReactor) HandleSignatureCollectionRequest(requestHash avsity
 		}
 	}()
 
-	// Get request from indexer
-	result, err := ar.dvsRequestIndexer.Get(requestHash)
-	if err != nil {
-		ar.logger.Error("AggregatorReactor: Get request from indexer failed", "error", err)
+	ctx := ar.eventManager.requestContexts.get(requestHash)
+	if err := ar.collectResponseSignature(ctx, requestHash); err != nil {
+		ar.setRequestStatus(requestHash, endStatus(ctx), err.Error())
 		return err
 	}
+	return nil
+}
 
-	// Extract the response and sign its digest
-	response := result.ResponseProcessDvsRequest
-	signature, err := ar.privValidator.SignBytes(response.ResponseDigest)
-	if err != nil {
-		ar.logger.Error("SignMessage failed", "error", err)
+// collectResponseSignature signs the response of a request, submits it to
+// the aggregator and publishes the validated response. Callers decide
+// whether an error fails the request.
+func (ar *AggregatorReactor) collectResponseSignature(ctx context.Context, requestHash avsitypes.DVSRequestHash) error {
+	if err := ctx.Err(); err != nil {
 		return err
 	}
-	ar.logger.Debug("responseWithSignature", "signature", signature)
-
-	// Convert the signature to the required BLS format
-	sig := bls.Signature{G1Point: &bls.G1Point{
-		G1Affine: signature.G1Affine,
-	}}
-
-	// Create a response with signature object for aggregation
-	responseWithSignature := aggtypes.ResponseWithSignature{
-		Data:        response.Response,
-		Signature:   &sig,
-		OperatorID:  ar.dvsState.operatorID,
-		RequestData: *result.DvsRequest,
-		Digest:      [32]byte(response.ResponseDigest),
+	responseWithSignature, err := ar.signResponse(requestHash)
+	if err != nil {
+		return err
 	}
 
 	// Create a channel to receive validated response
@@ -102,7 +95,8 @@ func (ar *AggregatorReactor) HandleSignatureCollectionRequest(requestHash avsity
 		"responseWithSignature", responseWithSignature,
 	)
 	// Send response signature to aggregator and wait for result
-	if err = ar.aggClient.CollectResponseSignature(&responseWithSignature, validatedResponseCh); err != nil {
+	ar.setRequestStatus(requestHash, avsitypes.DVS_REQUEST_STATUS_SUBMITTED, "")
+	if err = ar.aggClient.CollectResponseSignature(ctx, responseWithSignature, validatedResponseCh); err != nil {
 		ar.logger.Error("Failed to send response signature to aggregator", "error", err)
 		return fmt.Errorf("failed to send response signature to aggregator: %v", err)
 	}
@@ -120,8 +114,196 @@ func (ar *AggregatorReactor) HandleSignatureCollectionRequest(requestHash avsity
 	)
 
 	// Publish the completion event with the aggregated response
-	ar.eventManager.eventBus.Pub(types.CollectResponseSignatureDone, aggregatedResponse)
+	if err := ar.eventManager.eventBus.Pub(types.CollectResponseSignatureDone, aggregatedResponse); err != nil {
+		return fmt.Errorf("failed to publish aggregated response: %w", err)
+	}
 
 	ar.logger.Info("HandleSignatureCollectionRequest done, event sent")
 	return nil
 }
+
+// HandleSignatureCollectionRequests signs the responses of several requests
+// and submits them to the aggregator in a single call when the aggregator
+// client accepts batches. Otherwise each request is handled on its own.
+// A completion event is published for every request the aggregator returned
+// a validated response for.
+func (ar *AggregatorReactor) HandleSignatureCollectionRequests(requestHashes []avsitypes.DVSRequestHash) error {
+	ar.logger.Info("HandleSignatureCollectionRequests", "requests", len(requestHashes))
+
+	batcher, ok := ar.aggClient.(aggtypes.BatchAggregator)
+	if !ok || len(requestHashes) == 1 {
+		var errs []error
+		for _, requestHash := range requestHashes {
+			if err := ar.HandleSignatureCollectionRequest(requestHash); err != nil {
+				errs = append(errs, err)
+			}
+		}
+		return errors.Join(errs...)
+	}
+
+	// recover from panic
+	defer func() {
+		if r := recover(); r != nil {
+			ar.logger.Error("HandleSignatureCollectionRequests panic",
+				"requests", len(requestHashes),
+				"error", fmt.Sprintf("%v", r),
+			)
+		}
+	}()
+
+	var (
+		errs      []error
+		hashes    = make([]avsitypes.DVSRequestHash, 0, len(requestHashes))
+		contexts  = make([]context.Context, 0, len(requestHashes))
+		responses = make([]*aggtypes.ResponseWithSignature, 0, len(requestHashes))
+	)
+	for _, requestHash := range requestHashes {
+		ctx := ar.eventManager.requestContexts.get(requestHash)
+		if err := ctx.Err(); err != nil {
+			ar.setRequestStatus(requestHash, avsitypes.DVS_REQUEST_STATUS_CANCELLED, err.Error())
+			errs = append(errs, err)
+			continue
+		}
+		responseWithSignature, err := ar.signResponse(requestHash)
+		if err != nil {
+			ar.setRequestStatus(requestHash, avsitypes.DVS_REQUEST_STATUS_FAILED, err.Error())
+			errs = append(errs, err)
+			continue
+		}
+		hashes = append(hashes, requestHash)
+		contexts = append(contexts, ctx)
+		responses = append(responses, responseWithSignature)
+	}
+	if len(responses) == 0 {
+		return errors.Join(errs...)
+	}
+
+	for _, requestHash := range hashes {
+		ar.setRequestStatus(requestHash, avsitypes.DVS_REQUEST_STATUS_SUBMITTED, "")
+	}
+	batchCtx, cancel := allDone(contexts)
+	defer cancel()
+	resultsCh := make(chan []aggtypes.ValidatedResponse, 1)
+	if err := batcher.CollectResponseSignatures(batchCtx, responses, resultsCh); err != nil {
+		ar.logger.Error("Failed to send response signatures to aggregator", "error", err)
+		err = fmt.Errorf("failed to send response signatures to aggregator: %v", err)
+		for i, requestHash := range hashes {
+			ar.setRequestStatus(requestHash, endStatus(contexts[i]), err.Error())
+		}
+		errs = append(errs, err)
+		return errors.Join(errs...)
+	}
+	results := <-resultsCh
+	if len(results) != len(hashes) {
+		// results cannot be matched with their requests, so none is trusted
+		err := fmt.Errorf("aggregator returned %d results for %d response signatures", len(results), len(hashes))
+		ar.logger.Error("Aggregator returned unexpected results", "error", err)
+		for _, requestHash := range hashes {
+			ar.setRequestStatus(requestHash, avsitypes.DVS_REQUEST_STATUS_FAILED, err.Error())
+		}
+		errs = append(errs, err)
+		return errors.Join(errs...)
+	}
+
+	for i, result := range results {
+		if result.Err != nil {
+			ar.logger.Error("Aggregator returned error",
+				"requestHash", hashes[i],
+				"error", result.Err,
+			)
+			errs = append(errs, &aggtypes.AggregationError{Err: result.Err})
+			ar.setRequestStatus(hashes[i], avsitypes.DVS_REQUEST_STATUS_FAILED, result.Err.Error())
+			continue
+		}
+		if err := ar.eventManager.eventBus.Pub(types.CollectResponseSignatureDone, AggregatorResponse{
+			requestHash:      hashes[i],
+			validateResponse: result,
+		}); err != nil {
+			err = fmt.Errorf("failed to publish aggregated response: %w", err)
+			ar.logger.Error("Aggregated response dropped", "requestHash", hashes[i], "error", err)
+			errs = append(errs, err)
+			ar.setRequestStatus(hashes[i], avsitypes.DVS_REQUEST_STATUS_FAILED, err.Error())
+		}
+	}
+
+	ar.logger.Info("HandleSignatureCollectionRequests done, events sent", "results", len(results))
+	return errors.Join(errs...)
+}
+
+// signResponse retrieves the processed request from the indexer and signs
+// its response digest with the operator BLS key and the submission with the
+// operator ECDSA key
+func (ar *AggregatorReactor) signResponse(requestHash avsitypes.DVSRequestHash) (*aggtypes.ResponseWithSignature, error) {
+	// Get request from indexer
+	result, err := ar.dvsRequestIndexer.Get(requestHash)
+	if err != nil {
+		ar.logger.Error("AggregatorReactor: Get request from indexer failed", "error", err)
+		return nil, err
+	}
+
+	// Extract the response and sign its digest
+	response := result.ResponseProcessDvsRequest
+	signature, err := ar.privValidator.SignBytes(response.ResponseDigest)
+	if err != nil {
+		ar.logger.Error("SignMessage failed", "error", err)
+		return nil, err
+	}
+	ar.logger.Debug("responseWithSignature", "signature", signature)
+
+	// Convert the signature to the required BLS format
+	sig := bls.Signature{G1Point: &bls.G1Point{
+		G1Affine: signature.G1Affine,
+	}}
+
+	// Create a response with signature object for aggregation
+	responseWithSignature := &aggtypes.ResponseWithSignature{
+		Data:        response.Response,
+		Signature:   &sig,
+		OperatorID:  ar.dvsState.operatorID,
+		RequestData: *result.DvsRequest,
+		Digest:      [32]byte(response.ResponseDigest),
+	}
+
+	// Authenticate the submission with the operator ECDSA key
+	if err = responseWithSignature.SignOperator(ar.dvsState.operatorKey); err != nil {
+		ar.logger.Error("Failed to sign submission with operator key", "error", err)
+		return nil, err
+	}
+
+	result.SetStatus(avsitypes.DVS_REQUEST_STATUS_SIGNED, cmttime.Now())
+	if err = ar.dvsRequestIndexer.Index(result); err != nil {
+		ar.logger.Error("Failed to save signed request", "error", err)
+		return nil, err
+	}
+	return responseWithSignature, nil
+}
+
+// setRequestStatus moves the indexed request to the status, failing or
+// cancelling it for the reason when the status is FAILED or CANCELLED.
+// Errors are only logged, as the request is handled whether its status is
+// saved or not.
+func (ar *AggregatorReactor) setRequestStatus(requestHash avsitypes.DVSRequestHash,
+	status avsitypes.DVSRequestStatus, reason string) {
+	result, err := ar.dvsRequestIndexer.Get(requestHash)
+	if err != nil || result == nil {
+		ar.logger.Error("Failed to get request to update its status",
+			"requestHash", requestHash, "status", status.Name(), "error", err)
+		return
+	}
+
+	switch status {
+	case avsitypes.DVS_REQUEST_STATUS_FAILED:
+		result.Fail(reason, cmttime.Now())
+	case avsitypes.DVS_REQUEST_STATUS_CANCELLED:
+		result.Cancel(reason, cmttime.Now())
+	default:
+		result.SetStatus(status, cmttime.Now())
+	}
+	if err := ar.dvsRequestIndexer.Index(result); err != nil {
+		ar.logger.Error("Failed to save request status",
+			"requestHash", requestHash, "status", status.Name(), "error", err)
+	}
+	if status.IsTerminal() {
+		ar.eventManager.requestContexts.release(requestHash)
+	}
+}
@@ -0,0 +1,68 @@
+package security
+
+import (
+	"context"
+	"testing"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPellNetwork/pelldvs-libs/log"
+	aggtypes "github.com/0xPellNetwork/pelldvs/aggregator/types"
+	avsitypes "github.com/0xPellNetwork/pelldvs/avsi/types"
+	"github.com/0xPellNetwork/pelldvs/crypto/bls"
+	"github.com/0xPellNetwork/pelldvs/privval"
+	"github.com/0xPellNetwork/pelldvs/state/requestindex/kv"
+	cmttime "github.com/0xPellNetwork/pelldvs/types/time"
+)
+
+// countingBatchAggregator answers every batch with count results
+type countingBatchAggregator struct {
+	count int
+}
+
+func (a countingBatchAggregator) CollectResponseSignature(context.Context, *aggtypes.ResponseWithSignature,
+	chan<- aggtypes.ValidatedResponse) error {
+	panic("batches only")
+}
+
+func (a countingBatchAggregator) CollectResponseSignatures(_ context.Context, _ []*aggtypes.ResponseWithSignature,
+	resultsCh chan<- []aggtypes.ValidatedResponse) error {
+	resultsCh <- make([]aggtypes.ValidatedResponse, a.count)
+	return nil
+}
+
+func TestHandleSignatureCollectionRequestsResultCount(t *testing.T) {
+	keyPair, err := bls.GenRandomBlsKeys()
+	require.NoError(t, err)
+	operatorKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	for _, count := range []int{1, 3} {
+		indexer := kv.NewDvsRequestIndex(dbm.NewMemDB())
+		indexer.SetLogger(log.NewNopLogger())
+		ar := CreateAggregatorReactor(countingBatchAggregator{count: count}, indexer,
+			privval.NewFilePV(*keyPair, ""), &DVSState{operatorKey: operatorKey},
+			log.NewNopLogger(), NewEventManager(log.NewNopLogger()))
+
+		var hashes []avsitypes.DVSRequestHash
+		for _, data := range []string{"first", "second"} {
+			result := &avsitypes.DVSRequestResult{
+				DvsRequest:                &avsitypes.DVSRequest{Data: []byte(data), Height: 10, ChainId: 1},
+				ResponseProcessDvsRequest: &avsitypes.ResponseProcessDVSRequest{ResponseDigest: make([]byte, 32)},
+			}
+			result.SetStatus(avsitypes.DVS_REQUEST_STATUS_APP_PROCESSED, cmttime.Now())
+			require.NoError(t, indexer.Index(result))
+			hashes = append(hashes, result.DvsRequest.Hash())
+		}
+
+		// results that do not match the batch fail every request of it
+		require.ErrorContains(t, ar.HandleSignatureCollectionRequests(hashes), "results for 2 response signatures")
+		for _, hash := range hashes {
+			saved, err := indexer.Get(hash)
+			require.NoError(t, err)
+			require.Equal(t, avsitypes.DVS_REQUEST_STATUS_FAILED, saved.Status)
+		}
+	}
+}
@@ -2,8 +2,10 @@ package security
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/big"
+	"slices"
 
 	"github.com/0xPellNetwork/pelldvs-interactor/interactor/reader"
 	evmtypes "github.com/0xPellNetwork/pelldvs-interactor/types"
@@ -14,12 +16,21 @@ import (
 	"github.com/0xPellNetwork/pelldvs/proxy"
 	"github.com/0xPellNetwork/pelldvs/state/requestindex"
 	"github.com/0xPellNetwork/pelldvs/types"
+	cmttime "github.com/0xPellNetwork/pelldvs/types/time"
 )
 
 const (
 	responseDigestLenLimit = 32
 )
 
+// ErrDVSRequestExists is returned when a request is handled again while it
+// is already indexed
+var ErrDVSRequestExists = errors.New("DVS request already exist")
+
+// ErrOperatorNotMember is returned when the operator of the node has no stake
+// in the groups of a request at its height, and so does not sign its response
+var ErrOperatorNotMember = errors.New("not a member at height")
+
 type DVSReactor struct {
 	config            config.PellConfig
 	ProxyApp          proxy.AppConns
@@ -28,6 +39,7 @@ type DVSReactor struct {
 	dvsRequestIndexer requestindex.DvsRequestIndexer
 	dvsReader         reader.DVSReader
 	eventManager      *EventManager
+	requestPolicy     *RequestPolicy
 }
 
 // CreateDVSReactor creates a new DVSReactor instance
@@ -39,6 +51,7 @@ func CreateDVSReactor(
 	dvsState *DVSState,
 	logger log.Logger,
 	eventManager *EventManager,
+	requestPolicy *RequestPolicy,
 ) (DVSReactor, error) {
 	dvs := DVSReactor{
 		config:            config,
@@ -48,6 +61,7 @@ func CreateDVSReactor(
 		dvsRequestIndexer: dvsRequestIndexer,
 		dvsReader:         dvsReader,
 		eventManager:      eventManager,
+		requestPolicy:     requestPolicy,
 	}
 	return dvs, nil
 }
@@ -67,9 +81,12 @@ func (dvs *DVSReactor) SaveDVSRequestResult(res *avsitypes.DVSRequestResult, fir
 			return err
 		}
 		if old != nil {
-			return fmt.Errorf("DVS request hash %X already exist", hash)
+			return fmt.Errorf("DVS request hash %X: %w", hash, ErrDVSRequestExists)
 		}
 	}
+	if res.Status.IsTerminal() {
+		defer dvs.eventManager.requestContexts.release(res.DvsRequest.Hash())
+	}
 	if err := dvs.dvsRequestIndexer.Index(res); err != nil {
 		dvs.logger.Debug("SaveDVSRequestResult Saving dvs request result",
 			"first", first,
@@ -98,50 +115,187 @@ func (dvs *DVSReactor) SaveDVSRequestResult(res *avsitypes.DVSRequestResult, fir
 	return nil
 }
 
-// HandleDVSRequest handles the DVS request
-func (dvs *DVSReactor) HandleDVSRequest(request avsitypes.DVSRequest) error {
-	// handle panic
-	defer func() {
-		if r := recover(); r != nil {
-			dvs.logger.Error("dvsReactor.HandleDVSRequest panic",
-				"error", fmt.Sprintf("%v", r),
-				"request", request,
-			)
-			var err error
-			// construct an error message
-			switch t := r.(type) {
-			case error:
-				err = fmt.Errorf("panic on dvsReactor.HandleDVSRequest: %w", t)
-			default:
-				err = fmt.Errorf("panic on dvsReactor.HandleDVSRequest: %v", r)
-			}
+// ValidateDVSRequest checks the request against the request policy of the
+// node. Rejected requests get an RPCError with the errcode of the failed check.
+func (dvs *DVSReactor) ValidateDVSRequest(request avsitypes.DVSRequest) error {
+	if dvs.requestPolicy == nil {
+		return nil
+	}
+	return dvs.requestPolicy.Check(request)
+}
 
-			// save the request with an error
-			result := avsitypes.DVSRequestResult{
-				DvsRequest: &request,
-				DvsResponse: &avsitypes.DVSResponse{
-					Error: err.Error(),
-				},
-			}
+// HandleDVSRequest handles the DVS request. Requests rejected by the request
+// policy are neither indexed nor passed to the application. The request is
+// cancelled with the context while HandleDVSRequest runs, and after the
+// request timeout of the config.
+func (dvs *DVSReactor) HandleDVSRequest(ctx context.Context, request avsitypes.DVSRequest) error {
+	if err := dvs.admitDVSRequest(request); err != nil {
+		return err
+	}
+	return dvs.handleDVSRequest(ctx, request)
+}
 
-			dvs.logger.Error("dvsReactor.HandleDVSRequest recover", "err", err.Error())
+// RetryDVSRequest handles a failed or cancelled request again from the
+// start, keeping the transitions of its previous attempt. Other requests are
+// left as they are.
+func (dvs *DVSReactor) RetryDVSRequest(ctx context.Context, request avsitypes.DVSRequest) error {
+	if err := dvs.admitDVSRequest(request); err != nil {
+		return err
+	}
+	return dvs.retryDVSRequest(ctx, request)
+}
 
-			if err := dvs.SaveDVSRequestResult(&result, false); err != nil {
-				dvs.logger.Error("dvsReactor SaveDVSRequestResult", "err", err.Error())
-			}
-		}
-	}()
+// SubmitDVSRequest handles a new request, or retries a failed or cancelled
+// one when retryFailed is set
+func (dvs *DVSReactor) SubmitDVSRequest(ctx context.Context, request avsitypes.DVSRequest, retryFailed bool) error {
+	if err := dvs.admitDVSRequest(request); err != nil {
+		return err
+	}
+	return dvs.SubmitAdmittedDVSRequest(ctx, request, retryFailed)
+}
+
+// SubmitAdmittedDVSRequest is SubmitDVSRequest for a request already
+// accepted by the request policy, which is not checked again, so that a
+// request is not rejected once it has been accepted
+func (dvs *DVSReactor) SubmitAdmittedDVSRequest(ctx context.Context, request avsitypes.DVSRequest,
+	retryFailed bool) error {
+	if retryFailed {
+		return dvs.retryDVSRequest(ctx, request)
+	}
+	return dvs.handleDVSRequest(ctx, request)
+}
+
+// admitDVSRequest checks the request against the request policy and logs
+// its rejection
+func (dvs *DVSReactor) admitDVSRequest(request avsitypes.DVSRequest) error {
+	if err := dvs.ValidateDVSRequest(request); err != nil {
+		dvs.logger.Error("dvsReactor rejected request", "err", err.Error())
+		return err
+	}
+	return nil
+}
+
+// handleDVSRequest handles an admitted request
+func (dvs *DVSReactor) handleDVSRequest(ctx context.Context, request avsitypes.DVSRequest) error {
+	defer dvs.recoverDVSRequest(request)
 	dvs.logger.Info("dvsReactor.HandleDVSRequest", "request", request)
 
+	reqCtx, cancel, stop := newRequestContext(ctx, dvs.config.RequestTimeout)
+	defer stop()
+
 	// First save the request
 	result := avsitypes.DVSRequestResult{
 		DvsRequest: &request,
 	}
+	result.SetStatus(avsitypes.DVS_REQUEST_STATUS_RECEIVED, cmttime.Now())
 	if err := dvs.SaveDVSRequestResult(&result, true); err != nil {
+		cancel()
 		dvs.logger.Error("dvsReactor dvsindex.Index", "err", err.Error())
 		return err
 	}
 
+	return dvs.startDVSRequest(reqCtx, cancel, &result)
+}
+
+// retryDVSRequest retries an admitted request
+func (dvs *DVSReactor) retryDVSRequest(ctx context.Context, request avsitypes.DVSRequest) error {
+	defer dvs.recoverDVSRequest(request)
+	dvs.logger.Info("dvsReactor.RetryDVSRequest", "request", request)
+
+	hash := request.Hash()
+	old, err := dvs.dvsRequestIndexer.Get(hash)
+	if err != nil {
+		return err
+	}
+	if old == nil {
+		return fmt.Errorf("DVS request hash %X not found", hash)
+	}
+	if !old.Status.IsRetryable() {
+		return fmt.Errorf("DVS request hash %X is %s, not FAILED or CANCELLED", hash, old.Status.Name())
+	}
+
+	reqCtx, cancel, stop := newRequestContext(ctx, dvs.config.RequestTimeout)
+	defer stop()
+
+	result := avsitypes.DVSRequestResult{
+		DvsRequest:  &request,
+		Transitions: old.Transitions,
+	}
+	result.SetStatus(avsitypes.DVS_REQUEST_STATUS_RECEIVED, cmttime.Now())
+	if err := dvs.SaveDVSRequestResult(&result, false); err != nil {
+		cancel()
+		dvs.logger.Error("dvsReactor dvsindex.Index", "err", err.Error())
+		return err
+	}
+
+	return dvs.startDVSRequest(reqCtx, cancel, &result)
+}
+
+// startDVSRequest passes a received request to the application and, once
+// processed, publishes it for signature collection. The context of the
+// request is kept for its later stages until it is done with.
+func (dvs *DVSReactor) startDVSRequest(ctx context.Context, cancel context.CancelFunc,
+	result *avsitypes.DVSRequestResult) error {
+	hash := result.DvsRequest.Hash()
+	dvs.eventManager.requestContexts.register(hash, ctx, cancel)
+
+	if err := dvs.processDVSRequest(ctx, result); err != nil {
+		return dvs.failRequest(ctx, result, err)
+	}
+
+	if err := dvs.eventManager.eventBus.Pub(types.CollectResponseSignatureRequest, hash); err != nil {
+		// nothing will collect the signature of a dropped request
+		return dvs.failRequest(ctx, result, fmt.Errorf("failed to start signature collection: %w", err))
+	}
+	return nil
+}
+
+// recoverDVSRequest saves the request as failed when handling it panics. It
+// must be deferred by the handler.
+func (dvs *DVSReactor) recoverDVSRequest(request avsitypes.DVSRequest) {
+	r := recover()
+	if r == nil {
+		return
+	}
+	dvs.logger.Error("dvsReactor.HandleDVSRequest panic",
+		"error", fmt.Sprintf("%v", r),
+		"request", request,
+	)
+	var err error
+	// construct an error message
+	switch t := r.(type) {
+	case error:
+		err = fmt.Errorf("panic on dvsReactor.HandleDVSRequest: %w", t)
+	default:
+		err = fmt.Errorf("panic on dvsReactor.HandleDVSRequest: %v", r)
+	}
+
+	// save the request with an error, keeping its previous transitions
+	result := avsitypes.DVSRequestResult{DvsRequest: &request}
+	if old, getErr := dvs.dvsRequestIndexer.Get(request.Hash()); getErr == nil && old != nil {
+		result = *old
+	}
+	result.DvsResponse = &avsitypes.DVSResponse{
+		Error: err.Error(),
+	}
+	result.Fail(err.Error(), cmttime.Now())
+
+	dvs.logger.Error("dvsReactor.HandleDVSRequest recover", "err", err.Error())
+
+	if err := dvs.SaveDVSRequestResult(&result, false); err != nil {
+		dvs.logger.Error("dvsReactor SaveDVSRequestResult", "err", err.Error())
+	}
+}
+
+// processDVSRequest passes a received request to the application along with
+// the operators of its groups, and saves the response of the application.
+// Callers decide whether an error fails the request.
+func (dvs *DVSReactor) processDVSRequest(ctx context.Context, result *avsitypes.DVSRequestResult) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	request := *result.DvsRequest
+
 	groupNumbers := make(evmtypes.GroupNumbers, len(request.GroupNumbers))
 	for i, v := range request.GroupNumbers {
 		groupNumbers[i] = evmtypes.GroupNumber(v)
@@ -160,6 +314,11 @@ func (dvs *DVSReactor) HandleDVSRequest(request avsitypes.DVSRequest) error {
 
 	dvs.logger.Info("dvsReactor.HandleDVSRequest operatorsDvsState count", "count", len(operatorsDvsState))
 
+	if err := dvs.checkMembership(request, operatorsDvsState); err != nil {
+		dvs.logger.Error("dvsReactor skipped request", "err", err.Error())
+		return err
+	}
+
 	operators := make([]*avsitypes.Operator, 0)
 	for _, operatorState := range operatorsDvsState {
 		stake := big.NewInt(0)
@@ -195,7 +354,7 @@ func (dvs *DVSReactor) HandleDVSRequest(request avsitypes.DVSRequest) error {
 		return fmt.Errorf("operators is empty")
 	}
 
-	response, err := dvs.ProxyApp.Dvs().ProcessDVSRequest(context.Background(), &avsitypes.RequestProcessDVSRequest{
+	response, err := dvs.ProxyApp.Dvs().ProcessDVSRequest(ctx, &avsitypes.RequestProcessDVSRequest{
 		Request:  &request,
 		Operator: operators,
 	})
@@ -209,20 +368,103 @@ func (dvs *DVSReactor) HandleDVSRequest(request avsitypes.DVSRequest) error {
 		dvs.logger.Error("responseDigest length is not equal to 32",
 			"responseDigest", response.ResponseDigest)
 		return fmt.Errorf("responseDigest length %d is not equal to %d",
-			response.ResponseDigest, responseDigestLenLimit)
+			len(response.ResponseDigest), responseDigestLenLimit)
 	}
 
 	// Second save the request
 	result.ResponseProcessDvsRequest = response
-	if err := dvs.SaveDVSRequestResult(&result, false); err != nil {
+	result.SetStatus(avsitypes.DVS_REQUEST_STATUS_APP_PROCESSED, cmttime.Now())
+	if err := dvs.SaveDVSRequestResult(result, false); err != nil {
 		dvs.logger.Error("dvsReactor dvsindex.Index", "err", err.Error())
 		return err
 	}
-
-	dvs.eventManager.eventBus.Pub(types.CollectResponseSignatureRequest, request.Hash())
 	return nil
 }
 
+// checkMembership returns ErrOperatorNotMember when the operator of the node
+// is not among the operators of the request groups, or has no stake in them
+func (dvs *DVSReactor) checkMembership(request avsitypes.DVSRequest,
+	operatorsDvsState map[evmtypes.OperatorID]evmtypes.OperatorDVSState) error {
+	operatorID := evmtypes.OperatorID(dvs.dvsState.operatorID)
+	if operatorState, ok := operatorsDvsState[operatorID]; ok {
+		for _, stakeAmount := range operatorState.StakePerGroup {
+			if stakeAmount != nil && stakeAmount.Sign() > 0 {
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf("operator %x is %w %d of groups %v",
+		operatorID, ErrOperatorNotMember, request.Height, request.GroupNumbers)
+}
+
+// CheckOperatorRegistration returns an error when the operator of the node
+// is not registered, in which case it is not a member of any group and does
+// not sign the responses to requests. When the config names the groups of
+// the operator, it also runs the membership check of requests on them at
+// the latest block of every accepted chain.
+func (dvs *DVSReactor) CheckOperatorRegistration(ctx context.Context) error {
+	operatorID := evmtypes.OperatorID(dvs.dvsState.operatorID)
+	info, err := dvs.dvsReader.GetOperatorInfoByID(operatorID)
+	if err != nil {
+		return fmt.Errorf("failed to read operator %x: %w", operatorID, err)
+	}
+	if info.Pubkeys.G1Pubkey == nil || info.Pubkeys.G2Pubkey == nil {
+		return fmt.Errorf("operator %x is not registered in any group", operatorID)
+	}
+
+	groups := dvs.config.OperatorGroupNumbers
+	if len(groups) == 0 || dvs.requestPolicy == nil {
+		return nil
+	}
+	heights, err := dvs.requestPolicy.latestHeights(ctx)
+	if err != nil {
+		return err
+	}
+	chainIDs := make([]int64, 0, len(heights))
+	for chainID := range heights {
+		chainIDs = append(chainIDs, chainID)
+	}
+	slices.Sort(chainIDs)
+
+	groupNumbers := make(evmtypes.GroupNumbers, len(groups))
+	for i, v := range groups {
+		groupNumbers[i] = evmtypes.GroupNumber(v)
+	}
+	var errs []error
+	for _, chainID := range chainIDs {
+		request := avsitypes.DVSRequest{ChainId: chainID, Height: heights[chainID], GroupNumbers: groups}
+		operatorsDvsState, err := dvs.dvsReader.GetOperatorsDVSStateAtBlock(uint64(chainID),
+			groupNumbers, uint32(request.Height))
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to read the operators of groups %v on chain %d: %w",
+				groups, chainID, err))
+			continue
+		}
+		if err := dvs.checkMembership(request, operatorsDvsState); err != nil {
+			errs = append(errs, fmt.Errorf("chain %d: %w", chainID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// failRequest saves the request as failed for the error, as cancelled when
+// the context of the request is done, or as skipped when the operator is not
+// a member of the request groups, and returns the error
+func (dvs *DVSReactor) failRequest(ctx context.Context, result *avsitypes.DVSRequestResult, err error) error {
+	switch {
+	case errors.Is(err, ErrOperatorNotMember):
+		result.SkipNotMember(err.Error(), cmttime.Now())
+	case endStatus(ctx) == avsitypes.DVS_REQUEST_STATUS_CANCELLED:
+		result.Cancel(err.Error(), cmttime.Now())
+	default:
+		result.Fail(err.Error(), cmttime.Now())
+	}
+	if saveErr := dvs.SaveDVSRequestResult(result, false); saveErr != nil {
+		dvs.logger.Error("dvsReactor failed to save failed request", "err", saveErr.Error())
+	}
+	return err
+}
+
 // OnRequestAfterAggregated is called after the request is aggregated
 func (dvs *DVSReactor) OnRequestAfterAggregated(requestHash avsitypes.DVSRequestHash,
 	validatedResponse aggtypes.ValidatedResponse) error {
@@ -260,6 +502,7 @@ func (dvs *DVSReactor) OnRequestAfterAggregated(requestHash avsitypes.DVSRequest
 		result.DvsResponse = &avsitypes.DVSResponse{
 			Error: errorMsg,
 		}
+		result.Fail(errorMsg, cmttime.Now())
 
 		// Save result with error
 		if err := dvs.SaveDVSRequestResult(result, false); err != nil {
@@ -282,6 +525,7 @@ func (dvs *DVSReactor) OnRequestAfterAggregated(requestHash avsitypes.DVSRequest
 		result.DvsResponse = &avsitypes.DVSResponse{
 			Error: "validatedResponse.SignersApkG2 or validatedResponse.SignersAggSigG1 is nil ",
 		}
+		result.Fail(result.DvsResponse.Error, cmttime.Now())
 
 		// Save result with error
 		if err := dvs.SaveDVSRequestResult(result, false); err != nil {
@@ -325,6 +569,7 @@ func (dvs *DVSReactor) OnRequestAfterAggregated(requestHash avsitypes.DVSRequest
 
 	// Third save the request, if the validatedResponse has no error
 	result.DvsResponse = &dvsResponse
+	result.SetStatus(avsitypes.DVS_REQUEST_STATUS_AGGREGATED, cmttime.Now())
 	dvs.logger.Info("dvsReactor.OnRequestAfterAggregated got res.DvsResponse to save",
 		"res.DvsResponse", result.DvsResponse)
 	if err := dvs.SaveDVSRequestResult(result, false); err != nil {
@@ -333,12 +578,39 @@ func (dvs *DVSReactor) OnRequestAfterAggregated(requestHash avsitypes.DVSRequest
 	}
 	dvs.logger.Info("dvsReactor.OnRequestAfterAggregated res.DvsResponse saved")
 
+	ctx := dvs.eventManager.requestContexts.get(requestHash)
+	if err := dvs.deliverDVSResponse(ctx, result); err != nil {
+		return dvs.failRequest(ctx, result, err)
+	}
+
+	// Log validated response details
+	dvs.logger.Info("Validated Response Details",
+		"Hash", validatedResponse.Hash,
+		"NonSignerGroupBitmapIndices", validatedResponse.NonSignerGroupBitmapIndices,
+		"NonSignersPubkeysG1", validatedResponse.NonSignersPubkeysG1,
+		"GroupApksG1", validatedResponse.GroupApksG1,
+		"SignersApkG2", validatedResponse.SignersApkG2,
+		"SignersAggSigG1", validatedResponse.SignersAggSigG1,
+		"GroupApkIndices", validatedResponse.GroupApkIndices,
+		"TotalStakeIndices", validatedResponse.TotalStakeIndices,
+		"NonSignerStakeIndices", validatedResponse.NonSignerStakeIndices,
+	)
+	return nil
+}
+
+// deliverDVSResponse passes the aggregated response of a request to the
+// application and saves the request as finalized. Callers decide whether an
+// error fails the request.
+func (dvs *DVSReactor) deliverDVSResponse(ctx context.Context, result *avsitypes.DVSRequestResult) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
 	// If no error, send validated response to proxy application
 	postResponse := &avsitypes.RequestProcessDVSResponse{
-		DvsResponse: &dvsResponse,
+		DvsResponse: result.DvsResponse,
 		DvsRequest:  result.DvsRequest,
 	}
-	responseProcessDVSResponse, err := dvs.ProxyApp.Dvs().ProcessDVSResponse(context.Background(), postResponse)
+	responseProcessDVSResponse, err := dvs.ProxyApp.Dvs().ProcessDVSResponse(ctx, postResponse)
 	if err != nil {
 		dvs.logger.Error("dvsReactor.pellProxyApp.ProcessDVSResponse", "err", err.Error())
 		return err
@@ -346,22 +618,10 @@ func (dvs *DVSReactor) OnRequestAfterAggregated(requestHash avsitypes.DVSRequest
 
 	// Fourth save the request
 	result.ResponseProcessDvsResponse = responseProcessDVSResponse
+	result.SetStatus(avsitypes.DVS_REQUEST_STATUS_FINALIZED, cmttime.Now())
 	if err := dvs.SaveDVSRequestResult(result, false); err != nil {
 		dvs.logger.Error("dvsReactor.dvsindex.Index dvsResponseIdx", "err", err.Error())
 		return err
 	}
-
-	// Log validated response details
-	dvs.logger.Info("Validated Response Details",
-		"Hash", validatedResponse.Hash,
-		"NonSignerGroupBitmapIndices", validatedResponse.NonSignerGroupBitmapIndices,
-		"NonSignersPubkeysG1", validatedResponse.NonSignersPubkeysG1,
-		"GroupApksG1", validatedResponse.GroupApksG1,
-		"SignersApkG2", validatedResponse.SignersApkG2,
-		"SignersAggSigG1", validatedResponse.SignersAggSigG1,
-		"GroupApkIndices", validatedResponse.GroupApkIndices,
-		"TotalStakeIndices", validatedResponse.TotalStakeIndices,
-		"NonSignerStakeIndices", validatedResponse.NonSignerStakeIndices,
-	)
 	return nil
 }
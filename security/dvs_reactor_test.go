@@ -0,0 +1,284 @@
+package security
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPellNetwork/pelldvs-interactor/interactor/reader"
+	evmtypes "github.com/0xPellNetwork/pelldvs-interactor/types"
+	"github.com/0xPellNetwork/pelldvs-libs/crypto/bls"
+	"github.com/0xPellNetwork/pelldvs-libs/log"
+	avsitypes "github.com/0xPellNetwork/pelldvs/avsi/types"
+	"github.com/0xPellNetwork/pelldvs/config"
+	"github.com/0xPellNetwork/pelldvs/proxy"
+	"github.com/0xPellNetwork/pelldvs/state/requestindex/kv"
+	"github.com/0xPellNetwork/pelldvs/types"
+	cmttime "github.com/0xPellNetwork/pelldvs/types/time"
+)
+
+// unavailableDVSReader fails to read the operators of every request
+type unavailableDVSReader struct {
+	reader.DVSReader
+}
+
+func (unavailableDVSReader) GetOperatorsDVSStateAtBlock(uint64, evmtypes.GroupNumbers,
+	uint32) (map[evmtypes.OperatorID]evmtypes.OperatorDVSState, error) {
+	return nil, errors.New("chain unavailable")
+}
+
+// stakedDVSReader returns the operators with their stake in every group
+type stakedDVSReader struct {
+	reader.DVSReader
+	stakes map[evmtypes.OperatorID]int64
+}
+
+func (r stakedDVSReader) GetOperatorsDVSStateAtBlock(uint64, evmtypes.GroupNumbers,
+	uint32) (map[evmtypes.OperatorID]evmtypes.OperatorDVSState, error) {
+	states := make(map[evmtypes.OperatorID]evmtypes.OperatorDVSState, len(r.stakes))
+	for id, stake := range r.stakes {
+		state := evmtypes.OperatorDVSState{}
+		state.OperatorID = id
+		state.StakePerGroup = map[evmtypes.GroupNumber]*big.Int{1: big.NewInt(stake)}
+		info, err := r.GetOperatorInfoByID(id)
+		if err != nil {
+			return nil, err
+		}
+		state.OperatorInfo = info
+		states[id] = state
+	}
+	return states, nil
+}
+
+// digestApp answers every request with a response digest
+type digestApp struct {
+	avsitypes.BaseApplication
+}
+
+func (digestApp) ProcessDVSRequest(context.Context, *avsitypes.RequestProcessDVSRequest,
+) (*avsitypes.ResponseProcessDVSRequest, error) {
+	return &avsitypes.ResponseProcessDVSRequest{ResponseDigest: make([]byte, responseDigestLenLimit)}, nil
+}
+
+func (r stakedDVSReader) GetOperatorInfoByID(operatorID evmtypes.OperatorID) (evmtypes.OperatorInfo, error) {
+	if _, ok := r.stakes[operatorID]; !ok {
+		return evmtypes.OperatorInfo{}, fmt.Errorf("operator %x not found", operatorID)
+	}
+	keyPair, err := bls.GenRandomBlsKeys()
+	if err != nil {
+		return evmtypes.OperatorInfo{}, err
+	}
+	info := evmtypes.OperatorInfo{}
+	info.Pubkeys.G1Pubkey = keyPair.GetPubKeyG1()
+	info.Pubkeys.G2Pubkey = keyPair.GetPubKeyG2()
+	return info, nil
+}
+
+func TestRetryDVSRequest(t *testing.T) {
+	indexer := kv.NewDvsRequestIndex(dbm.NewMemDB())
+	indexer.SetLogger(log.NewNopLogger())
+	dvsReactor, err := CreateDVSReactor(*config.DefaultPellConfig(), nil, indexer, unavailableDVSReader{}, nil,
+		log.NewNopLogger(), NewEventManager(log.NewNopLogger()), nil)
+	require.NoError(t, err)
+
+	request := avsitypes.DVSRequest{Data: []byte("data"), Height: 10, ChainId: 1}
+	require.ErrorContains(t, dvsReactor.HandleDVSRequest(context.Background(), request), "chain unavailable")
+
+	// the request is not handled again
+	require.ErrorIs(t, dvsReactor.HandleDVSRequest(context.Background(), request), ErrDVSRequestExists)
+
+	// a failed request is retried, keeping the transitions of the first attempt
+	require.ErrorContains(t, dvsReactor.SubmitDVSRequest(context.Background(), request, true), "chain unavailable")
+	saved, err := indexer.Get(request.Hash())
+	require.NoError(t, err)
+	require.Equal(t, avsitypes.DVS_REQUEST_STATUS_FAILED, saved.Status)
+	statuses := make([]avsitypes.DVSRequestStatus, 0, len(saved.Transitions))
+	for _, transition := range saved.Transitions {
+		statuses = append(statuses, transition.Status)
+	}
+	require.Equal(t, []avsitypes.DVSRequestStatus{
+		avsitypes.DVS_REQUEST_STATUS_RECEIVED,
+		avsitypes.DVS_REQUEST_STATUS_FAILED,
+		avsitypes.DVS_REQUEST_STATUS_RECEIVED,
+		avsitypes.DVS_REQUEST_STATUS_FAILED,
+	}, statuses)
+
+	// requests that did not fail are not retried
+	saved.SetStatus(avsitypes.DVS_REQUEST_STATUS_FINALIZED, cmttime.Now())
+	require.NoError(t, indexer.Index(saved))
+	require.ErrorContains(t, dvsReactor.RetryDVSRequest(context.Background(), request), "not FAILED")
+	saved, err = indexer.Get(request.Hash())
+	require.NoError(t, err)
+	require.Equal(t, avsitypes.DVS_REQUEST_STATUS_FINALIZED, saved.Status)
+}
+
+func TestCancelDVSRequest(t *testing.T) {
+	indexer := kv.NewDvsRequestIndex(dbm.NewMemDB())
+	indexer.SetLogger(log.NewNopLogger())
+	dvsReactor, err := CreateDVSReactor(*config.DefaultPellConfig(), nil, indexer, unavailableDVSReader{}, nil,
+		log.NewNopLogger(), NewEventManager(log.NewNopLogger()), nil)
+	require.NoError(t, err)
+
+	// a request whose caller went away is cancelled, not failed
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	request := avsitypes.DVSRequest{Data: []byte("data"), Height: 10, ChainId: 1}
+	require.ErrorIs(t, dvsReactor.HandleDVSRequest(ctx, request), context.Canceled)
+	saved, err := indexer.Get(request.Hash())
+	require.NoError(t, err)
+	require.Equal(t, avsitypes.DVS_REQUEST_STATUS_CANCELLED, saved.Status)
+	require.Equal(t, context.Canceled.Error(), saved.FailureReason)
+
+	// a cancelled request may be retried
+	require.ErrorContains(t, dvsReactor.SubmitDVSRequest(context.Background(), request, true), "chain unavailable")
+	saved, err = indexer.Get(request.Hash())
+	require.NoError(t, err)
+	require.Equal(t, avsitypes.DVS_REQUEST_STATUS_FAILED, saved.Status)
+}
+
+func TestRequestContext(t *testing.T) {
+	// the request outlives its parent once stopped, until its timeout
+	parent, cancelParent := context.WithCancel(context.Background())
+	ctx, cancel, stop := newRequestContext(parent, 50*time.Millisecond)
+	defer cancel()
+	stop()
+	cancelParent()
+	require.NoError(t, ctx.Err())
+	require.Eventually(t, func() bool { return ctx.Err() == context.DeadlineExceeded }, time.Second, time.Millisecond)
+
+	// the request is cancelled with its parent while not stopped
+	parent, cancelParent = context.WithCancel(context.Background())
+	ctx, cancel, stop = newRequestContext(parent, 0)
+	defer cancel()
+	defer stop()
+	_, hasDeadline := ctx.Deadline()
+	require.False(t, hasDeadline)
+	cancelParent()
+	require.Eventually(t, func() bool { return ctx.Err() == context.Canceled }, time.Second, time.Millisecond)
+}
+
+func TestDVSRequestNotMember(t *testing.T) {
+	self := types.OperatorID{1}
+	testCases := []struct {
+		name   string
+		stakes map[evmtypes.OperatorID]int64
+	}{
+		{"not in the groups", map[evmtypes.OperatorID]int64{{2}: 100}},
+		{"without stake", map[evmtypes.OperatorID]int64{{1}: 0, {2}: 100}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			indexer := kv.NewDvsRequestIndex(dbm.NewMemDB())
+			indexer.SetLogger(log.NewNopLogger())
+			dvsReactor, err := CreateDVSReactor(*config.DefaultPellConfig(), nil, indexer,
+				stakedDVSReader{stakes: tc.stakes}, &DVSState{operatorID: self},
+				log.NewNopLogger(), NewEventManager(log.NewNopLogger()), nil)
+			require.NoError(t, err)
+
+			// the request is skipped before reaching the application
+			request := avsitypes.DVSRequest{Data: []byte("data"), Height: 10, ChainId: 1, GroupNumbers: []uint32{1}}
+			require.ErrorIs(t, dvsReactor.HandleDVSRequest(context.Background(), request), ErrOperatorNotMember)
+			saved, err := indexer.Get(request.Hash())
+			require.NoError(t, err)
+			require.Equal(t, avsitypes.DVS_REQUEST_STATUS_NOT_MEMBER, saved.Status)
+			require.Contains(t, saved.FailureReason, "not a member at height 10")
+
+			// skipped requests are not retried
+			require.ErrorContains(t, dvsReactor.RetryDVSRequest(context.Background(), request), "not FAILED")
+		})
+	}
+}
+
+func TestDroppedSignatureRequestFailsRequest(t *testing.T) {
+	indexer := kv.NewDvsRequestIndex(dbm.NewMemDB())
+	indexer.SetLogger(log.NewNopLogger())
+	proxyApp := proxy.NewAppConns(proxy.NewLocalClientCreator(digestApp{}), proxy.NopMetrics())
+	require.NoError(t, proxyApp.Start())
+	t.Cleanup(func() { _ = proxyApp.Stop() })
+
+	// a subscriber that does not keep up with signature collection requests
+	const queueSize = 1
+	eventManager := NewEventManager(log.NewNopLogger(), WithEventQueueSize(queueSize))
+	ch := eventManager.eventBus.Sub(types.CollectResponseSignatureRequest)
+	t.Cleanup(func() { eventManager.eventBus.Unsub(types.CollectResponseSignatureRequest, ch) })
+	for i := 0; i < cap(ch)+queueSize; i++ {
+		require.NoError(t, eventManager.eventBus.Pub(types.CollectResponseSignatureRequest, i))
+	}
+
+	self := types.OperatorID{1}
+	dvsReactor, err := CreateDVSReactor(*config.DefaultPellConfig(), proxyApp, indexer,
+		stakedDVSReader{stakes: map[evmtypes.OperatorID]int64{{1}: 100}}, &DVSState{operatorID: self},
+		log.NewNopLogger(), eventManager, nil)
+	require.NoError(t, err)
+
+	// the request is failed instead of waiting for a signature nothing collects
+	request := avsitypes.DVSRequest{Data: []byte("data"), Height: 10, ChainId: 1, GroupNumbers: []uint32{1}}
+	require.ErrorIs(t, dvsReactor.HandleDVSRequest(context.Background(), request), types.ErrReactorEventDropped)
+	saved, err := indexer.Get(request.Hash())
+	require.NoError(t, err)
+	require.Equal(t, avsitypes.DVS_REQUEST_STATUS_FAILED, saved.Status)
+	require.False(t, eventManager.requestContexts.has(request.Hash()))
+}
+
+func TestCheckOperatorRegistration(t *testing.T) {
+	self := types.OperatorID{1}
+	testCases := []struct {
+		name   string
+		stakes map[evmtypes.OperatorID]int64
+		groups []uint32
+		errs   []string
+	}{
+		{"not registered", map[evmtypes.OperatorID]int64{{2}: 100}, []uint32{1}, []string{"not found"}},
+		{"registered, no groups configured", map[evmtypes.OperatorID]int64{{1}: 0}, nil, nil},
+		{"member of its groups", map[evmtypes.OperatorID]int64{{1}: 100}, []uint32{1, 2}, nil},
+		{"without stake in its groups", map[evmtypes.OperatorID]int64{{1}: 0, {2}: 100}, []uint32{1, 2},
+			[]string{"chain 1: ", "chain 2: ", "not a member at height 10 of groups [1 2]"}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := config.DefaultPellConfig()
+			cfg.OperatorGroupNumbers = tc.groups
+			policy := NewRequestPolicy(*cfg, []int64{1, 2}, fixedHeights{height: 10})
+			dvsReactor, err := CreateDVSReactor(*cfg, nil, nil,
+				stakedDVSReader{stakes: tc.stakes}, &DVSState{operatorID: self},
+				log.NewNopLogger(), NewEventManager(log.NewNopLogger()), policy)
+			require.NoError(t, err)
+
+			err = dvsReactor.CheckOperatorRegistration(context.Background())
+			if len(tc.errs) == 0 {
+				require.NoError(t, err)
+				return
+			}
+			for _, msg := range tc.errs {
+				require.ErrorContains(t, err, msg)
+			}
+		})
+	}
+}
+
+func TestSubmitAdmittedDVSRequest(t *testing.T) {
+	indexer := kv.NewDvsRequestIndex(dbm.NewMemDB())
+	indexer.SetLogger(log.NewNopLogger())
+	cfg := config.DefaultPellConfig()
+	policy := NewRequestPolicy(*cfg, []int64{1}, fixedHeights{err: errors.New("chain height unavailable")})
+	dvsReactor, err := CreateDVSReactor(*cfg, nil, indexer, unavailableDVSReader{}, nil,
+		log.NewNopLogger(), NewEventManager(log.NewNopLogger()), policy)
+	require.NoError(t, err)
+
+	request := avsitypes.DVSRequest{
+		Data: []byte("data"), Height: 10, ChainId: 1,
+		GroupNumbers: []uint32{1}, GroupThresholdPercentages: []uint32{67},
+	}
+	require.ErrorContains(t, dvsReactor.SubmitDVSRequest(context.Background(), request, false),
+		"chain height unavailable")
+
+	// a request admitted earlier is not checked against the policy again
+	require.ErrorContains(t, dvsReactor.SubmitAdmittedDVSRequest(context.Background(), request, false),
+		"chain unavailable")
+}
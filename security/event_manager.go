@@ -1,27 +1,90 @@
 package security
 
 import (
+	"sync"
+	"time"
+
 	"github.com/0xPellNetwork/pelldvs-libs/log"
 	avsitypes "github.com/0xPellNetwork/pelldvs/avsi/types"
 	"github.com/0xPellNetwork/pelldvs/types"
 )
 
+const (
+	// DefaultEventWorkers is the default number of events of each type
+	// handled at the same time
+	DefaultEventWorkers = 8
+
+	// maxRequestBatch bounds the number of signature collection requests
+	// submitted to the aggregator together
+	maxRequestBatch = 16
+)
+
 // EventManager coordinates communication between different components of the system
-// by managing event subscriptions and routing events to appropriate handlers
+// by managing event subscriptions and routing events to appropriate handlers.
+// Each event type is handled by its own pool of workers, so that requests
+// waiting on the aggregator do not hold back the others.
 type EventManager struct {
 	logger            log.Logger
 	eventBus          *types.ReactorEventBus
 	aggregatorReactor *AggregatorReactor
 	dvsReactor        *DVSReactor
+	metrics           *Metrics
+	workers           int
+	requestContexts   *requestContexts
+
+	mtx           sync.Mutex
+	subscriptions map[types.ReactorEventType]<-chan types.ReactorEvent
+	dispatchers   sync.WaitGroup
+}
+
+// EventManagerOption sets an optional parameter on the EventManager.
+type EventManagerOption func(*EventManager)
+
+// WithEventWorkers sets the number of events of each type handled at the
+// same time. Values below 1 are ignored.
+func WithEventWorkers(workers int) EventManagerOption {
+	return func(em *EventManager) {
+		if workers > 0 {
+			em.workers = workers
+		}
+	}
+}
+
+// WithEventQueueSize sets the number of events queued for each subscriber
+// before further events are dropped. Values below 1 are ignored.
+func WithEventQueueSize(size int) EventManagerOption {
+	return func(em *EventManager) { em.eventBus.SetQueueSize(size) }
+}
+
+// WithEventMetrics sets the metrics of the EventManager.
+func WithEventMetrics(metrics *Metrics) EventManagerOption {
+	return func(em *EventManager) { em.metrics = metrics }
 }
 
 // NewEventManager creates a new EventManager instance with the provided logger
 // and initializes an internal event bus for communication
-func NewEventManager(logger log.Logger) *EventManager {
-	return &EventManager{
-		logger:   logger,
-		eventBus: types.NewReactorEventBus(),
+func NewEventManager(logger log.Logger, options ...EventManagerOption) *EventManager {
+	em := &EventManager{
+		logger:          logger,
+		eventBus:        types.NewReactorEventBus(),
+		metrics:         NopMetrics(),
+		workers:         DefaultEventWorkers,
+		requestContexts: newRequestContexts(),
+		subscriptions:   make(map[types.ReactorEventType]<-chan types.ReactorEvent),
+	}
+	for _, option := range options {
+		option(em)
 	}
+
+	em.eventBus.SetSlowConsumerHandler(func(eventType types.ReactorEventType, queued int) {
+		em.metrics.SlowConsumerEvents.With("event_type", string(eventType)).Add(1)
+		em.metrics.QueuedEvents.With("event_type", string(eventType)).Set(float64(queued))
+	})
+	em.eventBus.SetDroppedEventHandler(func(eventType types.ReactorEventType) {
+		em.metrics.DroppedEvents.With("event_type", string(eventType)).Add(1)
+		em.logger.Error("Dropped event, the queue of its subscriber is full", "event_type", eventType)
+	})
+	return em
 }
 
 // SetDVSReactor assigns the DVS reactor component to the EventManager
@@ -39,48 +102,110 @@ func (em *EventManager) SetAggregatorReactor(aggregatorReactor *AggregatorReacto
 // StartListening begins asynchronous event processing by subscribing to relevant
 // event types and dispatching them to the appropriate handlers
 func (em *EventManager) StartListening() {
+	// Signature collection requests are handled together with the ones
+	// already queued behind them
+	em.listen(types.CollectResponseSignatureRequest, maxRequestBatch, em.handleSignatureRequests)
+	em.listen(types.CollectResponseSignatureDone, 1, em.handleSignatureDone)
+}
+
+// Stop unsubscribes the EventManager from the event bus and waits for its
+// dispatchers to return. Events being handled are left to finish.
+func (em *EventManager) Stop() {
+	em.mtx.Lock()
+	for eventType, ch := range em.subscriptions {
+		em.eventBus.Unsub(eventType, ch)
+		delete(em.subscriptions, eventType)
+	}
+	em.mtx.Unlock()
+
+	em.dispatchers.Wait()
+}
+
+// listen subscribes to the event type and hands the events, in batches of
+// up to batchLimit, to the handler on a pool of workers. When all workers are
+// busy, further events wait on the event bus.
+func (em *EventManager) listen(eventType types.ReactorEventType, batchLimit int,
+	handle func(events []types.ReactorEvent)) {
+	ch := em.eventBus.Sub(eventType)
+	em.mtx.Lock()
+	em.subscriptions[eventType] = ch
+	em.mtx.Unlock()
+
+	em.dispatchers.Add(1)
 	go func() {
-		// Subscribe to signature collection request and completion events
-		requestCh := em.eventBus.Sub(types.CollectResponseSignatureRequest)
-		responseCh := em.eventBus.Sub(types.CollectResponseSignatureDone)
-
-		for {
-			select {
-			case event := <-requestCh:
-				// Handle signature collection request events
-				if event.Type == types.CollectResponseSignatureRequest {
-					em.logger.Info("Received CollectResponseSignatureRequest")
-
-					// Extract the request hash from the event payload
-					requestHash := event.Payload.(avsitypes.DVSRequestHash)
-					// Forward to the aggregator reactor for processing
-					if err := em.aggregatorReactor.HandleSignatureCollectionRequest(requestHash); err != nil {
-						em.logger.Error("failed to handle aggregator request", "error", err)
-					}
-
-					em.logger.Info("Handled CollectResponseSignatureRequest")
-				}
-
-			case event := <-responseCh:
-				// Handle signature collection completion events
-				if event.Type == types.CollectResponseSignatureDone {
-					em.logger.Info("Received CollectResponseSignatureDone")
-
-					// Extract the aggregated response from the event payload
-					res := event.Payload.(AggregatorResponse)
-
-					em.logger.Info("EventManager, got typed AggregatorResponse to send to OnRequestAfterAggregated",
-						"requestHash", res.requestHash,
-						"validateResponse", res.validateResponse,
-					)
-					// Forward to the DVS reactor for post-aggregation processing
-					if err := em.dvsReactor.OnRequestAfterAggregated(res.requestHash, res.validateResponse); err != nil {
-						em.logger.Error("failed to handle aggregator request", "error", err)
-					}
-
-					em.logger.Info("Handled CollectResponseSignatureDone")
-				}
-			}
+		defer em.dispatchers.Done()
+
+		workers := make(chan struct{}, em.workers)
+		for event := range ch {
+			events := drainEvents(ch, []types.ReactorEvent{event}, batchLimit)
+
+			workers <- struct{}{}
+			go func() {
+				defer func() { <-workers }()
+
+				running := em.metrics.HandlersRunning.With("event_type", string(eventType))
+				running.Add(1)
+				defer running.Add(-1)
+				start := time.Now()
+				handle(events)
+				em.metrics.HandlingDurationSeconds.With("event_type", string(eventType)).
+					Observe(time.Since(start).Seconds())
+			}()
 		}
 	}()
 }
+
+// handleSignatureRequests forwards signature collection requests to the
+// aggregator reactor
+func (em *EventManager) handleSignatureRequests(events []types.ReactorEvent) {
+	requestHashes := make([]avsitypes.DVSRequestHash, 0, len(events))
+	for _, event := range events {
+		requestHashes = append(requestHashes, event.Payload.(avsitypes.DVSRequestHash))
+	}
+	em.logger.Info("Received CollectResponseSignatureRequest", "requests", len(requestHashes))
+
+	// Forward to the aggregator reactor for processing
+	if err := em.aggregatorReactor.HandleSignatureCollectionRequests(requestHashes); err != nil {
+		em.logger.Error("failed to handle aggregator request", "error", err)
+	}
+
+	em.logger.Info("Handled CollectResponseSignatureRequest")
+}
+
+// handleSignatureDone forwards the aggregated responses to the DVS reactor
+func (em *EventManager) handleSignatureDone(events []types.ReactorEvent) {
+	for _, event := range events {
+		em.logger.Info("Received CollectResponseSignatureDone")
+
+		// Extract the aggregated response from the event payload
+		res := event.Payload.(AggregatorResponse)
+
+		em.logger.Info("EventManager, got typed AggregatorResponse to send to OnRequestAfterAggregated",
+			"requestHash", res.requestHash,
+			"validateResponse", res.validateResponse,
+		)
+		// Forward to the DVS reactor for post-aggregation processing
+		if err := em.dvsReactor.OnRequestAfterAggregated(res.requestHash, res.validateResponse); err != nil {
+			em.logger.Error("failed to handle aggregator request", "error", err)
+		}
+
+		em.logger.Info("Handled CollectResponseSignatureDone")
+	}
+}
+
+// drainEvents appends the events already queued on the channel, without
+// waiting for more, until there are limit events
+func drainEvents(ch <-chan types.ReactorEvent, events []types.ReactorEvent, limit int) []types.ReactorEvent {
+	for len(events) < limit {
+		select {
+		case event, ok := <-ch:
+			if !ok {
+				return events
+			}
+			events = append(events, event)
+		default:
+			return events
+		}
+	}
+	return events
+}
@@ -0,0 +1,53 @@
+package security
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPellNetwork/pelldvs-libs/log"
+	"github.com/0xPellNetwork/pelldvs/types"
+)
+
+func TestEventManagerWorkers(t *testing.T) {
+	em := NewEventManager(log.NewNopLogger(), WithEventWorkers(2))
+	eventType := types.ReactorEventType("test_event")
+
+	var running, handled atomic.Int32
+	release := make(chan struct{})
+	em.listen(eventType, 1, func(events []types.ReactorEvent) {
+		running.Add(1)
+		<-release
+		running.Add(-1)
+		handled.Add(int32(len(events)))
+	})
+
+	for i := 0; i < 3; i++ {
+		require.NoError(t, em.eventBus.Pub(eventType, i))
+	}
+
+	// two events are handled at the same time, the third waits for a worker
+	require.Eventually(t, func() bool { return running.Load() == 2 }, time.Second, time.Millisecond)
+	require.Never(t, func() bool { return running.Load() > 2 }, 50*time.Millisecond, time.Millisecond)
+
+	close(release)
+	require.Eventually(t, func() bool { return handled.Load() == 3 }, time.Second, time.Millisecond)
+
+	em.Stop()
+	require.NoError(t, em.eventBus.Pub(eventType, 4))
+	require.Never(t, func() bool { return handled.Load() > 3 }, 50*time.Millisecond, time.Millisecond)
+}
+
+func TestDrainEvents(t *testing.T) {
+	ch := make(chan types.ReactorEvent, 4)
+	for i := 0; i < 3; i++ {
+		ch <- types.ReactorEvent{Payload: i}
+	}
+
+	first := []types.ReactorEvent{{Payload: -1}}
+	require.Len(t, drainEvents(ch, first, 2), 2)
+	require.Len(t, drainEvents(ch, first, 10), 3)
+	require.Len(t, drainEvents(ch, first, 10), 1)
+}
@@ -0,0 +1,37 @@
+package security
+
+import (
+	"github.com/go-kit/kit/metrics"
+)
+
+const (
+	// MetricsSubsystem is a subsystem shared by all metrics exposed by this
+	// package.
+	MetricsSubsystem = "security"
+)
+
+//go:generate go run ../scripts/metricsgen -struct=Metrics
+
+// Metrics contains the metrics of the reactor events and the request queue
+// of a node.
+type Metrics struct {
+	// Number of events queued because a subscriber had no room left for
+	// them, by event type.
+	SlowConsumerEvents metrics.Counter `metrics_labels:"event_type"`
+	// Number of events queued for a subscriber when the last one was queued,
+	// by event type.
+	QueuedEvents metrics.Gauge `metrics_labels:"event_type"`
+	// Number of events dropped because the queue of a subscriber was full,
+	// by event type.
+	DroppedEvents metrics.Counter `metrics_labels:"event_type"`
+	// Number of event handlers running, by event type.
+	HandlersRunning metrics.Gauge `metrics_labels:"event_type"`
+	// Time spent handling an event, by event type.
+	HandlingDurationSeconds metrics.Histogram `metrics_labels:"event_type" metrics_buckettype:"exprange" metrics_bucketsizes:"0.01, 60, 12"`
+	// Number of DVS requests waiting in the request queue.
+	RequestQueueDepth metrics.Gauge
+	// Time a DVS request waited in the request queue for a worker.
+	RequestQueueWaitSeconds metrics.Histogram `metrics_buckettype:"exprange" metrics_bucketsizes:"0.001, 60, 12"`
+	// Number of DVS requests refused because the request queue was full.
+	RequestsRejectedBusy metrics.Counter
+}
@@ -0,0 +1,117 @@
+package security
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	avsitypes "github.com/0xPellNetwork/pelldvs/avsi/types"
+)
+
+// requestContexts holds the contexts of the requests being handled, so that
+// the stages of a request running on other goroutines, from the aggregator
+// call to the delivery of the aggregated response, stop with it
+type requestContexts struct {
+	mtx      sync.Mutex
+	contexts map[string]requestContext
+}
+
+type requestContext struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+func newRequestContexts() *requestContexts {
+	return &requestContexts{contexts: make(map[string]requestContext)}
+}
+
+// newRequestContext returns the context of a request handled on behalf of
+// the parent. It is cancelled with the parent until stop is called, once
+// the caller is no longer waiting for the request, and expires after the
+// timeout, or with the parent deadline if it is earlier. A timeout of 0
+// means no limit.
+func newRequestContext(parent context.Context, timeout time.Duration) (
+	ctx context.Context, cancel context.CancelFunc, stop func() bool) {
+	ctx = context.WithoutCancel(parent)
+	deadline, hasDeadline := parent.Deadline()
+	if timeout > 0 && (!hasDeadline || time.Now().Add(timeout).Before(deadline)) {
+		deadline, hasDeadline = time.Now().Add(timeout), true
+	}
+	if hasDeadline {
+		ctx, cancel = context.WithDeadline(ctx, deadline)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+	return ctx, cancel, context.AfterFunc(parent, cancel)
+}
+
+// register makes the context the one of the request until it is released
+func (rc *requestContexts) register(hash avsitypes.DVSRequestHash, ctx context.Context, cancel context.CancelFunc) {
+	rc.mtx.Lock()
+	defer rc.mtx.Unlock()
+	if old, ok := rc.contexts[string(hash)]; ok {
+		old.cancel()
+	}
+	rc.contexts[string(hash)] = requestContext{ctx: ctx, cancel: cancel}
+}
+
+// get returns the context of the request, or a background context for the
+// requests handled with none, such as the ones resumed after a restart
+func (rc *requestContexts) get(hash avsitypes.DVSRequestHash) context.Context {
+	rc.mtx.Lock()
+	defer rc.mtx.Unlock()
+	if reqCtx, ok := rc.contexts[string(hash)]; ok {
+		return reqCtx.ctx
+	}
+	return context.Background()
+}
+
+// has reports whether the request is being handled with a context
+func (rc *requestContexts) has(hash avsitypes.DVSRequestHash) bool {
+	rc.mtx.Lock()
+	defer rc.mtx.Unlock()
+	_, ok := rc.contexts[string(hash)]
+	return ok
+}
+
+// release cancels the context of a request that is done with
+func (rc *requestContexts) release(hash avsitypes.DVSRequestHash) {
+	rc.mtx.Lock()
+	defer rc.mtx.Unlock()
+	if reqCtx, ok := rc.contexts[string(hash)]; ok {
+		reqCtx.cancel()
+		delete(rc.contexts, string(hash))
+	}
+}
+
+// endStatus returns the status a request ends in when it fails in the
+// context: CANCELLED when the context is done, FAILED otherwise
+func endStatus(ctx context.Context) avsitypes.DVSRequestStatus {
+	if ctx.Err() != nil {
+		return avsitypes.DVS_REQUEST_STATUS_CANCELLED
+	}
+	return avsitypes.DVS_REQUEST_STATUS_FAILED
+}
+
+// allDone returns a context that is done once all the contexts are, for the
+// work shared by several requests
+func allDone(contexts []context.Context) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(context.Background())
+	var remaining atomic.Int32
+	remaining.Store(int32(len(contexts)))
+	stops := make([]func() bool, 0, len(contexts))
+	for _, c := range contexts {
+		stops = append(stops, context.AfterFunc(c, func() {
+			if remaining.Add(-1) == 0 {
+				cancel()
+			}
+		}))
+	}
+	return ctx, func() {
+		for _, stop := range stops {
+			stop()
+		}
+		cancel()
+	}
+}
@@ -0,0 +1,148 @@
+package security
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	avsitypes "github.com/0xPellNetwork/pelldvs/avsi/types"
+	"github.com/0xPellNetwork/pelldvs/config"
+	"github.com/0xPellNetwork/pelldvs/rpc/core/errcode"
+	rpctypes "github.com/0xPellNetwork/pelldvs/rpc/jsonrpc/types"
+)
+
+// chainHeightTimeout bounds the read of the latest height of a chain when
+// checking a request
+const chainHeightTimeout = 5 * time.Second
+
+// ChainHeightReader returns the latest block height of a chain
+type ChainHeightReader interface {
+	LatestHeight(ctx context.Context, chainID int64) (int64, error)
+}
+
+// RequestPolicy decides which DVS requests the node accepts. The checks run
+// in a fixed order, so that a request is always rejected with the same
+// error, and only the height checks depend on the state of the chain.
+type RequestPolicy struct {
+	maxAgeBlocks  int64
+	allowedChains map[int64]struct{}
+	maxDataSize   int
+	heights       ChainHeightReader
+}
+
+// NewRequestPolicy creates the request policy of the Pell config. When the
+// config allows no chain, the known chains are allowed. Request heights are
+// only checked when a height reader is given.
+func NewRequestPolicy(cfg config.PellConfig, knownChains []int64, heights ChainHeightReader) *RequestPolicy {
+	chains := cfg.RequestAllowedChains
+	if len(chains) == 0 {
+		chains = knownChains
+	}
+	allowedChains := make(map[int64]struct{}, len(chains))
+	for _, chainID := range chains {
+		allowedChains[chainID] = struct{}{}
+	}
+	return &RequestPolicy{
+		maxAgeBlocks:  cfg.RequestMaxAgeBlocks,
+		allowedChains: allowedChains,
+		maxDataSize:   cfg.RequestMaxDataSize,
+		heights:       heights,
+	}
+}
+
+// Check returns an RPCError with the errcode of the first check the request
+// fails, or nil if it is accepted
+func (p *RequestPolicy) Check(request avsitypes.DVSRequest) error {
+	if p.maxDataSize > 0 && len(request.Data) > p.maxDataSize {
+		return requestError(errcode.RequestTooLarge, "Request too large",
+			"data size %d exceeds the limit of %d bytes", len(request.Data), p.maxDataSize)
+	}
+	if err := checkGroups(request); err != nil {
+		return err
+	}
+	if _, ok := p.allowedChains[request.ChainId]; !ok {
+		return requestError(errcode.ChainNotAllowed, "Chain not allowed",
+			"requests for chain %d are not accepted", request.ChainId)
+	}
+	if request.Height <= 0 {
+		return requestError(errcode.InvalidRequest, "Invalid request", "height %d is not positive", request.Height)
+	}
+	return p.checkHeight(request)
+}
+
+// checkGroups rejects requests whose groups and thresholds do not pair up
+func checkGroups(request avsitypes.DVSRequest) error {
+	if len(request.GroupNumbers) == 0 {
+		return requestError(errcode.InvalidRequest, "Invalid request", "no group numbers")
+	}
+	if len(request.GroupNumbers) != len(request.GroupThresholdPercentages) {
+		return requestError(errcode.InvalidRequest, "Invalid request",
+			"%d group numbers but %d threshold percentages",
+			len(request.GroupNumbers), len(request.GroupThresholdPercentages))
+	}
+	seen := make(map[uint32]struct{}, len(request.GroupNumbers))
+	for i, groupNumber := range request.GroupNumbers {
+		if _, ok := seen[groupNumber]; ok {
+			return requestError(errcode.InvalidRequest, "Invalid request", "duplicate group number %d", groupNumber)
+		}
+		seen[groupNumber] = struct{}{}
+
+		threshold := request.GroupThresholdPercentages[i]
+		if threshold == 0 || threshold > 100 {
+			return requestError(errcode.InvalidRequest, "Invalid request",
+				"threshold percentage %d of group %d is not between 1 and 100", threshold, groupNumber)
+		}
+	}
+	return nil
+}
+
+// checkHeight rejects requests for heights past the latest block of their
+// chain or older than the maximum age. Requests whose chain height can not be
+// read are refused as unavailable, to be retried later.
+func (p *RequestPolicy) checkHeight(request avsitypes.DVSRequest) error {
+	if p.heights == nil {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), chainHeightTimeout)
+	defer cancel()
+	latest, err := p.heights.LatestHeight(ctx, request.ChainId)
+	if err != nil {
+		return requestError(errcode.ChainUnavailable, "Chain unavailable",
+			"failed to read the latest height of chain %d: %v", request.ChainId, err)
+	}
+
+	if request.Height > latest {
+		return requestError(errcode.RequestInFuture, "Request in future",
+			"height %d is past the latest block %d of chain %d", request.Height, latest, request.ChainId)
+	}
+	if p.maxAgeBlocks > 0 && latest-request.Height > p.maxAgeBlocks {
+		return requestError(errcode.RequestTooOld, "Request too old",
+			"height %d is more than %d blocks behind the latest block %d of chain %d",
+			request.Height, p.maxAgeBlocks, latest, request.ChainId)
+	}
+	return nil
+}
+
+// latestHeights returns the latest height of each accepted chain by chain
+// ID. It returns no heights when request heights are not checked.
+func (p *RequestPolicy) latestHeights(ctx context.Context) (map[int64]int64, error) {
+	if p.heights == nil {
+		return nil, nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, chainHeightTimeout)
+	defer cancel()
+	heights := make(map[int64]int64, len(p.allowedChains))
+	for chainID := range p.allowedChains {
+		latest, err := p.heights.LatestHeight(ctx, chainID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the latest height of chain %d: %w", chainID, err)
+		}
+		heights[chainID] = latest
+	}
+	return heights, nil
+}
+
+func requestError(code int, message string, format string, args ...any) *rpctypes.RPCError {
+	return &rpctypes.RPCError{Code: code, Message: message, Data: fmt.Sprintf(format, args...)}
+}
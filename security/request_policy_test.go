@@ -0,0 +1,91 @@
+package security
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	avsitypes "github.com/0xPellNetwork/pelldvs/avsi/types"
+	"github.com/0xPellNetwork/pelldvs/config"
+	"github.com/0xPellNetwork/pelldvs/rpc/core/errcode"
+	rpctypes "github.com/0xPellNetwork/pelldvs/rpc/jsonrpc/types"
+)
+
+// fixedHeights reports the same latest height for every chain
+type fixedHeights struct {
+	height int64
+	err    error
+}
+
+func (h fixedHeights) LatestHeight(_ context.Context, _ int64) (int64, error) {
+	return h.height, h.err
+}
+
+func TestRequestPolicy(t *testing.T) {
+	cfg := config.DefaultPellConfig()
+	cfg.RequestMaxAgeBlocks = 100
+	cfg.RequestMaxDataSize = 4
+	policy := NewRequestPolicy(*cfg, []int64{1, 2}, fixedHeights{height: 1000})
+
+	valid := avsitypes.DVSRequest{
+		Data:                      []byte("data"),
+		Height:                    950,
+		ChainId:                   1,
+		GroupNumbers:              []uint32{0, 1},
+		GroupThresholdPercentages: []uint32{67, 100},
+	}
+	require.NoError(t, policy.Check(valid))
+
+	testCases := []struct {
+		name   string
+		modify func(r *avsitypes.DVSRequest)
+		code   int
+	}{
+		{"data too large", func(r *avsitypes.DVSRequest) { r.Data = []byte("large") }, errcode.RequestTooLarge},
+		{"no groups", func(r *avsitypes.DVSRequest) {
+			r.GroupNumbers, r.GroupThresholdPercentages = nil, nil
+		}, errcode.InvalidRequest},
+		{"mismatched thresholds", func(r *avsitypes.DVSRequest) {
+			r.GroupThresholdPercentages = []uint32{67}
+		}, errcode.InvalidRequest},
+		{"duplicate group", func(r *avsitypes.DVSRequest) { r.GroupNumbers = []uint32{1, 1} }, errcode.InvalidRequest},
+		{"zero threshold", func(r *avsitypes.DVSRequest) {
+			r.GroupThresholdPercentages = []uint32{0, 100}
+		}, errcode.InvalidRequest},
+		{"threshold above 100", func(r *avsitypes.DVSRequest) {
+			r.GroupThresholdPercentages = []uint32{67, 101}
+		}, errcode.InvalidRequest},
+		{"unknown chain", func(r *avsitypes.DVSRequest) { r.ChainId = 3 }, errcode.ChainNotAllowed},
+		{"no height", func(r *avsitypes.DVSRequest) { r.Height = 0 }, errcode.InvalidRequest},
+		{"future height", func(r *avsitypes.DVSRequest) { r.Height = 1001 }, errcode.RequestInFuture},
+		{"too old", func(r *avsitypes.DVSRequest) { r.Height = 899 }, errcode.RequestTooOld},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			request := valid
+			tc.modify(&request)
+			err := policy.Check(request)
+			var rpcErr *rpctypes.RPCError
+			require.ErrorAs(t, err, &rpcErr)
+			require.Equal(t, tc.code, rpcErr.Code)
+		})
+	}
+
+	// configured chains replace the known ones
+	cfg.RequestAllowedChains = []int64{3}
+	policy = NewRequestPolicy(*cfg, []int64{1, 2}, nil)
+	require.Error(t, policy.Check(valid))
+	valid.ChainId = 3
+	valid.Height = 5000
+	require.NoError(t, policy.Check(valid))
+
+	// requests whose height can not be checked are refused as unavailable
+	policy = NewRequestPolicy(*cfg, nil, fixedHeights{err: errors.New("connection refused")})
+	err := policy.Check(valid)
+	require.ErrorContains(t, err, "connection refused")
+	var rpcErr *rpctypes.RPCError
+	require.ErrorAs(t, err, &rpcErr)
+	require.Equal(t, errcode.ChainUnavailable, rpcErr.Code)
+}
@@ -0,0 +1,146 @@
+package security
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/0xPellNetwork/pelldvs-libs/log"
+	avsitypes "github.com/0xPellNetwork/pelldvs/avsi/types"
+	"github.com/0xPellNetwork/pelldvs/rpc/core/errcode"
+	rpctypes "github.com/0xPellNetwork/pelldvs/rpc/jsonrpc/types"
+)
+
+// ErrRequestQueueFull refuses a request while the request queue is full
+var ErrRequestQueueFull = &rpctypes.RPCError{
+	Code:    errcode.NodeBusy,
+	Message: "Node busy",
+	Data:    "request queue is full, retry later",
+}
+
+// ErrRequestQueueStopped refuses a request while the node shuts down
+var ErrRequestQueueStopped = &rpctypes.RPCError{
+	Code:    errcode.ShuttingDown,
+	Message: "Shutting down",
+	Data:    "node is shutting down",
+}
+
+// queuedRequest is a request waiting in the request queue
+type queuedRequest struct {
+	request     avsitypes.DVSRequest
+	retryFailed bool
+	enqueued    time.Time
+}
+
+// RequestQueue hands the DVS requests accepted by the node to a fixed pool of
+// workers. Requests wait in a bounded queue for a free worker, and are
+// refused when the queue is full, so that a burst of requests slows the
+// callers down instead of the node.
+type RequestQueue struct {
+	handle  func(ctx context.Context, request avsitypes.DVSRequest, retryFailed bool) error
+	workers int
+	metrics *Metrics
+	logger  log.Logger
+
+	// ctx is cancelled when the queue fails to drain in time
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	mtx     sync.RWMutex
+	queue   chan queuedRequest
+	stopped bool
+	running sync.WaitGroup
+}
+
+// NewRequestQueue creates a RequestQueue of the given size, whose workers
+// pass the requests to handle. The callers of queued requests do not wait
+// for them, so the requests are handled with a context of the queue, which
+// is only cancelled when stopping it times out.
+func NewRequestQueue(handle func(ctx context.Context, request avsitypes.DVSRequest, retryFailed bool) error,
+	size, workers int,
+	metrics *Metrics, logger log.Logger) *RequestQueue {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &RequestQueue{
+		handle:  handle,
+		workers: workers,
+		metrics: metrics,
+		logger:  logger,
+		ctx:     ctx,
+		cancel:  cancel,
+		queue:   make(chan queuedRequest, size),
+	}
+}
+
+// Start starts the workers of the queue
+func (q *RequestQueue) Start() {
+	for i := 0; i < q.workers; i++ {
+		q.running.Add(1)
+		go q.work()
+	}
+}
+
+// Enqueue adds the request to the queue, to be retried if retryFailed is
+// set. It returns ErrRequestQueueFull when the queue has no room left and
+// ErrRequestQueueStopped once it is stopped.
+func (q *RequestQueue) Enqueue(request avsitypes.DVSRequest, retryFailed bool) error {
+	q.mtx.RLock()
+	defer q.mtx.RUnlock()
+
+	if q.stopped {
+		return ErrRequestQueueStopped
+	}
+	select {
+	case q.queue <- queuedRequest{request: request, retryFailed: retryFailed, enqueued: time.Now()}:
+		q.metrics.RequestQueueDepth.Set(float64(len(q.queue)))
+		return nil
+	default:
+		q.metrics.RequestsRejectedBusy.Add(1)
+		return ErrRequestQueueFull
+	}
+}
+
+// Stop refuses further requests and waits up to the timeout for the queued
+// ones to be handled. After the timeout, the context of the requests still
+// being handled or queued is cancelled, so that they end as CANCELLED, and
+// Stop waits for the workers to exit.
+func (q *RequestQueue) Stop(timeout time.Duration) error {
+	q.mtx.Lock()
+	if q.stopped {
+		q.mtx.Unlock()
+		return nil
+	}
+	defer q.cancel()
+	q.stopped = true
+	close(q.queue)
+	q.mtx.Unlock()
+
+	drained := make(chan struct{})
+	go func() {
+		q.running.Wait()
+		close(drained)
+	}()
+
+	select {
+	case <-drained:
+		return nil
+	case <-time.After(timeout):
+	}
+
+	queued := len(q.queue)
+	q.cancel()
+	<-drained
+	return fmt.Errorf("%d requests still queued after %v, cancelled them", queued, timeout)
+}
+
+func (q *RequestQueue) work() {
+	defer q.running.Done()
+	for queued := range q.queue {
+		q.metrics.RequestQueueDepth.Set(float64(len(q.queue)))
+		q.metrics.RequestQueueWaitSeconds.Observe(time.Since(queued.enqueued).Seconds())
+
+		if err := q.handle(q.ctx, queued.request, queued.retryFailed); err != nil {
+			q.logger.Error("Failed to handle queued request", "hash", queued.request.Hash(), "err", err)
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package security
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPellNetwork/pelldvs-libs/log"
+	avsitypes "github.com/0xPellNetwork/pelldvs/avsi/types"
+	"github.com/0xPellNetwork/pelldvs/rpc/core/errcode"
+	rpctypes "github.com/0xPellNetwork/pelldvs/rpc/jsonrpc/types"
+)
+
+func TestRequestQueue(t *testing.T) {
+	var started, handled atomic.Int32
+	release := make(chan struct{})
+	queue := NewRequestQueue(func(context.Context, avsitypes.DVSRequest, bool) error {
+		started.Add(1)
+		<-release
+		handled.Add(1)
+		return nil
+	}, 1, 1, NopMetrics(), log.NewNopLogger())
+	queue.Start()
+
+	request := func(height int64) avsitypes.DVSRequest {
+		return avsitypes.DVSRequest{Data: []byte("data"), Height: height, ChainId: 1}
+	}
+
+	// the first request is handled by the worker, the second one waits
+	require.NoError(t, queue.Enqueue(request(1), false))
+	require.Eventually(t, func() bool { return started.Load() == 1 }, time.Second, time.Millisecond)
+	require.NoError(t, queue.Enqueue(request(2), false))
+
+	err := queue.Enqueue(request(3), false)
+	var rpcErr *rpctypes.RPCError
+	require.ErrorAs(t, err, &rpcErr)
+	require.Equal(t, errcode.NodeBusy, rpcErr.Code)
+
+	// stopping waits for the queued requests
+	stopped := make(chan error)
+	go func() { stopped <- queue.Stop(time.Second) }()
+	require.Eventually(t, func() bool {
+		err := queue.Enqueue(request(4), false)
+		return errors.As(err, &rpcErr) && rpcErr.Code == errcode.ShuttingDown
+	}, time.Second, time.Millisecond)
+
+	close(release)
+	require.NoError(t, <-stopped)
+	require.EqualValues(t, 2, handled.Load())
+	require.NoError(t, queue.Stop(time.Second))
+}
+
+func TestRequestQueueStopTimeout(t *testing.T) {
+	var started, handled, cancelled atomic.Int32
+	release := make(chan struct{})
+	defer close(release)
+	queue := NewRequestQueue(func(ctx context.Context, _ avsitypes.DVSRequest, _ bool) error {
+		started.Add(1)
+		select {
+		case <-release:
+			handled.Add(1)
+			return nil
+		case <-ctx.Done():
+			cancelled.Add(1)
+			return ctx.Err()
+		}
+	}, 1, 1, NopMetrics(), log.NewNopLogger())
+	queue.Start()
+
+	request := func(height int64) avsitypes.DVSRequest {
+		return avsitypes.DVSRequest{Data: []byte("data"), Height: height, ChainId: 1}
+	}
+	require.NoError(t, queue.Enqueue(request(1), false))
+	require.Eventually(t, func() bool { return started.Load() == 1 }, time.Second, time.Millisecond)
+	require.NoError(t, queue.Enqueue(request(2), false))
+
+	// once the timeout expires, the requests being handled and the queued
+	// ones are cancelled, and the workers have exited when Stop returns
+	require.Error(t, queue.Stop(10*time.Millisecond))
+	require.EqualValues(t, 2, started.Load())
+	require.EqualValues(t, 2, cancelled.Load())
+	require.Zero(t, handled.Load())
+}
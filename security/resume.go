@@ -0,0 +1,212 @@
+package security
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/0xPellNetwork/pelldvs-libs/log"
+	avsitypes "github.com/0xPellNetwork/pelldvs/avsi/types"
+	"github.com/0xPellNetwork/pelldvs/config"
+	"github.com/0xPellNetwork/pelldvs/libs/query"
+	"github.com/0xPellNetwork/pelldvs/state/requestindex"
+	"github.com/0xPellNetwork/pelldvs/types"
+	cmttime "github.com/0xPellNetwork/pelldvs/types/time"
+)
+
+// resumableStatuses are the statuses of requests that were still in flight
+// when the node stopped
+var resumableStatuses = []avsitypes.DVSRequestStatus{
+	avsitypes.DVS_REQUEST_STATUS_RECEIVED,
+	avsitypes.DVS_REQUEST_STATUS_APP_PROCESSED,
+	avsitypes.DVS_REQUEST_STATUS_SIGNED,
+	avsitypes.DVS_REQUEST_STATUS_SUBMITTED,
+	avsitypes.DVS_REQUEST_STATUS_AGGREGATED,
+}
+
+// RequestResumer continues the requests the node left unfinished when it
+// stopped, from the last status saved for them. The attempts of a request are
+// saved with it, so that a request which keeps failing, or keeps stopping the
+// node, is marked as failed after the maximum number of attempts.
+type RequestResumer struct {
+	dvsReactor        *DVSReactor
+	aggregatorReactor *AggregatorReactor
+	dvsRequestIndexer requestindex.DvsRequestIndexer
+	maxAttempts       uint32
+	backoff           time.Duration
+	requestTimeout    time.Duration
+	logger            log.Logger
+}
+
+// NewRequestResumer creates a RequestResumer with the retry limits and the
+// request timeout of the Pell config
+func NewRequestResumer(
+	cfg config.PellConfig,
+	dvsReactor *DVSReactor,
+	aggregatorReactor *AggregatorReactor,
+	dvsRequestIndexer requestindex.DvsRequestIndexer,
+	logger log.Logger,
+) *RequestResumer {
+	return &RequestResumer{
+		dvsReactor:        dvsReactor,
+		aggregatorReactor: aggregatorReactor,
+		dvsRequestIndexer: dvsRequestIndexer,
+		maxAttempts:       uint32(cfg.RequestResumeMaxAttempts),
+		backoff:           cfg.RequestResumeBackoff,
+		requestTimeout:    cfg.RequestTimeout,
+		logger:            logger,
+	}
+}
+
+// Unfinished returns the requests left unfinished by the last run of the
+// node. It must be called before the node accepts requests, so that the
+// requests of the current run are not taken for unfinished ones.
+func (r *RequestResumer) Unfinished(ctx context.Context) []*avsitypes.DVSRequestResult {
+	if r.maxAttempts == 0 {
+		return nil
+	}
+
+	var results []*avsitypes.DVSRequestResult
+	for _, status := range resumableStatuses {
+		q := query.MustCompile(fmt.Sprintf("%s='%s'", types.DVSStatusKey, status.Name()))
+		found, err := r.dvsRequestIndexer.Search(ctx, q)
+		if err != nil {
+			r.logger.Error("Failed to search unfinished requests", "status", status.Name(), "err", err)
+			continue
+		}
+		results = append(results, found...)
+	}
+	return results
+}
+
+// Run resumes the unfinished requests one after the other. It returns when
+// every request is finished or failed, or when the context is done, leaving
+// the remaining requests to the next start of the node. Requests handled
+// again by the node in the meantime are left to it.
+func (r *RequestResumer) Run(ctx context.Context, results []*avsitypes.DVSRequestResult) {
+	if r.maxAttempts == 0 || len(results) == 0 {
+		return
+	}
+
+	r.logger.Info("Resuming unfinished requests", "count", len(results))
+	for _, result := range results {
+		if ctx.Err() != nil {
+			return
+		}
+		if r.dvsReactor.eventManager.requestContexts.has(result.DvsRequest.Hash()) {
+			r.logger.Info("Skipping request handled again", "requestHash", result.DvsRequest.Hash())
+			continue
+		}
+		r.resume(ctx, result)
+	}
+}
+
+// resume retries the request with an exponential backoff until it moves on
+// or runs out of attempts
+func (r *RequestResumer) resume(ctx context.Context, result *avsitypes.DVSRequestResult) {
+	requestHash := result.DvsRequest.Hash()
+	backoff := r.backoff
+	var lastErr error
+	for {
+		if result.ResumeAttempts >= r.maxAttempts {
+			reason := fmt.Sprintf("failed to resume request after %d attempts", result.ResumeAttempts)
+			if lastErr != nil {
+				reason = fmt.Sprintf("%s: %v", reason, lastErr)
+			}
+			r.logger.Error("Giving up unfinished request", "requestHash", requestHash, "reason", reason)
+			result.Fail(reason, cmttime.Now())
+			if err := r.dvsRequestIndexer.Index(result); err != nil {
+				r.logger.Error("Failed to save failed request", "requestHash", requestHash, "err", err)
+			}
+			return
+		}
+
+		result.ResumeAttempts++
+		if err := r.dvsRequestIndexer.Index(result); err != nil {
+			r.logger.Error("Failed to save resume attempt", "requestHash", requestHash, "err", err)
+			return
+		}
+
+		r.logger.Info("Resuming request",
+			"requestHash", requestHash,
+			"status", result.Status.Name(),
+			"attempt", result.ResumeAttempts,
+		)
+		lastErr = r.step(ctx, result)
+		if lastErr == nil {
+			return
+		}
+		r.logger.Error("Failed to resume request", "requestHash", requestHash, "err", lastErr)
+
+		if result.ResumeAttempts < r.maxAttempts {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(backoff):
+			}
+			backoff *= 2
+		}
+
+		// the reactors save the request as it moves on, so continue from
+		// the saved request
+		saved, err := r.dvsRequestIndexer.Get(requestHash)
+		if err != nil || saved == nil {
+			r.logger.Error("Failed to reload unfinished request", "requestHash", requestHash, "err", err)
+			return
+		}
+		if !isResumable(saved.Status) {
+			return
+		}
+		result = saved
+	}
+}
+
+// step moves the request on from its saved status. A request received by the
+// node is passed to the application again, a processed request is signed and
+// submitted again, and an aggregated response is delivered again. Each step
+// is limited to the request timeout of the config.
+func (r *RequestResumer) step(ctx context.Context, result *avsitypes.DVSRequestResult) (err error) {
+	defer func() {
+		if p := recover(); p != nil {
+			err = fmt.Errorf("panic on resuming request: %v", p)
+		}
+	}()
+
+	if r.requestTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, r.requestTimeout)
+		defer cancel()
+	}
+
+	requestHash := result.DvsRequest.Hash()
+	switch result.Status {
+	case avsitypes.DVS_REQUEST_STATUS_RECEIVED:
+		if err := r.dvsReactor.processDVSRequest(ctx, result); err != nil {
+			if errors.Is(err, ErrOperatorNotMember) {
+				// the membership at the height of the request does not change
+				_ = r.dvsReactor.failRequest(ctx, result, err)
+				return nil
+			}
+			return err
+		}
+		return r.aggregatorReactor.collectResponseSignature(ctx, requestHash)
+	case avsitypes.DVS_REQUEST_STATUS_APP_PROCESSED,
+		avsitypes.DVS_REQUEST_STATUS_SIGNED,
+		avsitypes.DVS_REQUEST_STATUS_SUBMITTED:
+		return r.aggregatorReactor.collectResponseSignature(ctx, requestHash)
+	case avsitypes.DVS_REQUEST_STATUS_AGGREGATED:
+		return r.dvsReactor.deliverDVSResponse(ctx, result)
+	default:
+		return nil
+	}
+}
+
+func isResumable(status avsitypes.DVSRequestStatus) bool {
+	for _, s := range resumableStatuses {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,159 @@
+package security
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPellNetwork/pelldvs-libs/log"
+	avsitypes "github.com/0xPellNetwork/pelldvs/avsi/types"
+	"github.com/0xPellNetwork/pelldvs/config"
+	"github.com/0xPellNetwork/pelldvs/proxy"
+	"github.com/0xPellNetwork/pelldvs/state/requestindex/kv"
+	cmttime "github.com/0xPellNetwork/pelldvs/types/time"
+)
+
+// flakyApp fails to process responses until it has been called failures times
+type flakyApp struct {
+	avsitypes.BaseApplication
+
+	mtx      sync.Mutex
+	failures int
+	calls    int
+}
+
+func (app *flakyApp) ProcessDVSResponse(context.Context, *avsitypes.RequestProcessDVSResponse,
+) (*avsitypes.ResponseProcessDVSResponse, error) {
+	app.mtx.Lock()
+	defer app.mtx.Unlock()
+	app.calls++
+	if app.calls <= app.failures {
+		return nil, errors.New("application unavailable")
+	}
+	return &avsitypes.ResponseProcessDVSResponse{}, nil
+}
+
+func TestRequestResumer(t *testing.T) {
+	testCases := []struct {
+		name          string
+		failures      int
+		savedAttempts uint32
+		status        avsitypes.DVSRequestStatus
+		attempts      uint32
+		calls         int
+	}{
+		{"finalized after retry", 1, 0, avsitypes.DVS_REQUEST_STATUS_FINALIZED, 2, 2},
+		{"failed after max attempts", 5, 0, avsitypes.DVS_REQUEST_STATUS_FAILED, 3, 3},
+		{"failed when attempts ran out before restart", 0, 3, avsitypes.DVS_REQUEST_STATUS_FAILED, 3, 0},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			indexer := kv.NewDvsRequestIndex(dbm.NewMemDB())
+			indexer.SetLogger(log.NewNopLogger())
+
+			app := &flakyApp{failures: tc.failures}
+			proxyApp := proxy.NewAppConns(proxy.NewLocalClientCreator(app), proxy.NopMetrics())
+			require.NoError(t, proxyApp.Start())
+			t.Cleanup(func() { _ = proxyApp.Stop() })
+
+			cfg := config.DefaultPellConfig()
+			cfg.RequestResumeBackoff = time.Millisecond
+			dvsReactor, err := CreateDVSReactor(*cfg, proxyApp, indexer, nil, nil,
+				log.NewNopLogger(), NewEventManager(log.NewNopLogger()), nil)
+			require.NoError(t, err)
+
+			// a request the node stopped after aggregating
+			result := &avsitypes.DVSRequestResult{
+				DvsRequest:     &avsitypes.DVSRequest{Data: []byte("data"), Height: 10, ChainId: 1},
+				DvsResponse:    &avsitypes.DVSResponse{Data: []byte("response")},
+				ResumeAttempts: tc.savedAttempts,
+			}
+			result.SetStatus(avsitypes.DVS_REQUEST_STATUS_AGGREGATED, cmttime.Now())
+			require.NoError(t, indexer.Index(result))
+
+			resumer := NewRequestResumer(*cfg, &dvsReactor, nil, indexer, log.NewNopLogger())
+			resumer.Run(context.Background(), resumer.Unfinished(context.Background()))
+
+			saved, err := indexer.Get(result.DvsRequest.Hash())
+			require.NoError(t, err)
+			require.Equal(t, tc.status, saved.Status)
+			require.Equal(t, tc.attempts, saved.ResumeAttempts)
+			require.Equal(t, tc.calls, app.calls)
+			if tc.status == avsitypes.DVS_REQUEST_STATUS_FAILED {
+				require.Contains(t, saved.FailureReason, "failed to resume request after 3 attempts")
+			}
+		})
+	}
+
+	// resuming is disabled without attempts
+	indexer := kv.NewDvsRequestIndex(dbm.NewMemDB())
+	result := &avsitypes.DVSRequestResult{DvsRequest: &avsitypes.DVSRequest{Data: []byte("data"), Height: 10, ChainId: 1}}
+	result.SetStatus(avsitypes.DVS_REQUEST_STATUS_AGGREGATED, cmttime.Now())
+	require.NoError(t, indexer.Index(result))
+
+	cfg := config.DefaultPellConfig()
+	cfg.RequestResumeMaxAttempts = 0
+	resumer := NewRequestResumer(*cfg, nil, nil, indexer, log.NewNopLogger())
+	resumer.Run(context.Background(), resumer.Unfinished(context.Background()))
+	saved, err := indexer.Get(result.DvsRequest.Hash())
+	require.NoError(t, err)
+	require.Equal(t, avsitypes.DVS_REQUEST_STATUS_AGGREGATED, saved.Status)
+	require.Zero(t, saved.ResumeAttempts)
+}
+
+func TestRequestResumerSkipsLiveRequests(t *testing.T) {
+	indexer := kv.NewDvsRequestIndex(dbm.NewMemDB())
+	indexer.SetLogger(log.NewNopLogger())
+
+	app := &flakyApp{}
+	proxyApp := proxy.NewAppConns(proxy.NewLocalClientCreator(app), proxy.NopMetrics())
+	require.NoError(t, proxyApp.Start())
+	t.Cleanup(func() { _ = proxyApp.Stop() })
+
+	cfg := config.DefaultPellConfig()
+	cfg.RequestResumeBackoff = time.Millisecond
+	eventManager := NewEventManager(log.NewNopLogger())
+	dvsReactor, err := CreateDVSReactor(*cfg, proxyApp, indexer, nil, nil,
+		log.NewNopLogger(), eventManager, nil)
+	require.NoError(t, err)
+
+	index := func(data string) *avsitypes.DVSRequestResult {
+		result := &avsitypes.DVSRequestResult{
+			DvsRequest:  &avsitypes.DVSRequest{Data: []byte(data), Height: 10, ChainId: 1},
+			DvsResponse: &avsitypes.DVSResponse{Data: []byte("response")},
+		}
+		result.SetStatus(avsitypes.DVS_REQUEST_STATUS_AGGREGATED, cmttime.Now())
+		require.NoError(t, indexer.Index(result))
+		return result
+	}
+	stale := index("stale")
+	retried := index("retried")
+
+	resumer := NewRequestResumer(*cfg, &dvsReactor, nil, indexer, log.NewNopLogger())
+	unfinished := resumer.Unfinished(context.Background())
+	require.Len(t, unfinished, 2)
+
+	// requests arriving once the unfinished ones are looked up, and
+	// unfinished ones submitted again, are handled by the node alone
+	live := index("live")
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	eventManager.requestContexts.register(retried.DvsRequest.Hash(), ctx, cancel)
+	resumer.Run(context.Background(), unfinished)
+
+	saved, err := indexer.Get(stale.DvsRequest.Hash())
+	require.NoError(t, err)
+	require.Equal(t, avsitypes.DVS_REQUEST_STATUS_FINALIZED, saved.Status)
+	for _, result := range []*avsitypes.DVSRequestResult{retried, live} {
+		saved, err := indexer.Get(result.DvsRequest.Hash())
+		require.NoError(t, err)
+		require.Equal(t, avsitypes.DVS_REQUEST_STATUS_AGGREGATED, saved.Status)
+		require.Zero(t, saved.ResumeAttempts)
+	}
+	require.Equal(t, 1, app.calls)
+}
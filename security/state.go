@@ -3,10 +3,12 @@
 package security
 
 import (
+	osecdsa "crypto/ecdsa"
 	"encoding/json"
 	"fmt"
 
 	dbm "github.com/cosmos/cosmos-db"
+	"github.com/ethereum/go-ethereum/crypto"
 
 	"github.com/0xPellNetwork/pelldvs/config"
 	"github.com/0xPellNetwork/pelldvs/crypto/ecdsa"
@@ -24,20 +26,21 @@ type RequestStore interface {
 // including operator identity and request storage
 type DVSState struct {
 	operatorID   types.OperatorID
+	operatorKey  *osecdsa.PrivateKey
 	requestStore RequestStore
 }
 
 // NewDVSState creates a new DVSState instance initialized with
 // the operator's identity and a storage implementation
 func NewDVSState(cfg *config.PellConfig, requestStore RequestStore, storeDir string) (*DVSState, error) {
-	// Get operator address from the ECDSA private key
-	operatorAddress, err := ecdsa.GetAddressFromKeyStoreFile(cfg.OperatorECDSAPrivateKeyStorePath)
+	// Load the operator ECDSA key, used to authenticate submissions to the aggregator
+	operatorKey, err := ecdsa.ReadKey(cfg.OperatorECDSAPrivateKeyStorePath, "")
 	if err != nil {
-		return nil, fmt.Errorf("failed to get operator address: %v", err)
+		return nil, fmt.Errorf("failed to read operator ECDSA key: %v", err)
 	}
 
 	// Generate operator ID from the address
-	operatorID := types.OperatorIDFromAddress(operatorAddress)
+	operatorID := types.OperatorIDFromAddress(crypto.PubkeyToAddress(operatorKey.PublicKey))
 
 	// If no requestStore is provided, create a local storage implementation
 	if requestStore == nil {
@@ -50,6 +53,7 @@ func NewDVSState(cfg *config.PellConfig, requestStore RequestStore, storeDir str
 
 	return &DVSState{
 		operatorID:   operatorID,
+		operatorKey:  operatorKey,
 		requestStore: requestStore,
 	}, nil
 }
@@ -109,6 +109,12 @@ func (dvsReqIdx *DvsRequestIndex) AddBatch(batch *requestindex.Batch) error {
 			}
 		}
 
+		// index by status, replacing the previous one
+		err = dvsReqIdx.indexStatus(result, hash, storeBatch)
+		if err != nil {
+			return err
+		}
+
 		// index by height (always)
 		err = storeBatch.Set(keyForHeight(result, dvsReqIdx.eventSeq), hash)
 		if err != nil {
@@ -152,6 +158,12 @@ func (dvsReqIdx *DvsRequestIndex) Index(result *avsi.DVSRequestResult) error {
 		}
 	}
 
+	// index by status, replacing the previous one
+	err = dvsReqIdx.indexStatus(result, hash, batch)
+	if err != nil {
+		return err
+	}
+
 	// index by height (always)
 	err = batch.Set(keyForHeight(result, dvsReqIdx.eventSeq), hash)
 	if err != nil {
@@ -171,6 +183,24 @@ func (dvsReqIdx *DvsRequestIndex) Index(result *avsi.DVSRequestResult) error {
 	return batch.WriteSync()
 }
 
+// indexStatus indexes the request by its status. A request has a single
+// status, so the key of the status it was previously indexed with is removed.
+func (dvsReqIdx *DvsRequestIndex) indexStatus(result *avsi.DVSRequestResult, hash []byte, store dbm.Batch) error {
+	old, err := dvsReqIdx.Get(hash)
+	if err != nil {
+		return err
+	}
+	if old != nil && old.Status != avsi.DVS_REQUEST_STATUS_UNKNOWN && old.Status != result.Status {
+		if err := store.Delete(keyForStatus(old, hash)); err != nil {
+			return err
+		}
+	}
+	if result.Status == avsi.DVS_REQUEST_STATUS_UNKNOWN {
+		return nil
+	}
+	return store.Set(keyForStatus(result, hash), hash)
+}
+
 func (dvsReqIdx *DvsRequestIndex) indexEvents(chainid, height int64, events []avsi.Event, hash []byte, store dbm.Batch) error {
 
 	if dvsReqIdx.eventSeq == nil {
@@ -197,7 +227,7 @@ func (dvsReqIdx *DvsRequestIndex) indexEvents(chainid, height int64, events []av
 			// index if `index: true` is set
 			compositeTag := fmt.Sprintf("%s.%s", event.Type, attr.Key)
 			// ensure event does not conflict with a reserved prefix key
-			if compositeTag == types.DVSHashKey {
+			if compositeTag == types.DVSHashKey || compositeTag == types.DVSStatusKey {
 				return fmt.Errorf("event type and attribute key \"%s\" is reserved; please use a different key", compositeTag)
 			}
 			if attr.GetIndex() {
@@ -837,6 +867,19 @@ func keyForEvent(key string, value string, chainid, height int64, eventSeq *big.
 	))
 }
 
+func keyForStatus(result *avsi.DVSRequestResult, hash []byte) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%d/%d/%s%X",
+		types.DVSStatusKey,
+		result.Status.Name(),
+		result.DvsRequest.ChainId,
+		result.DvsRequest.Height,
+		// the hash takes the place of the event sequence, as a request is
+		// indexed with a single status
+		eventSeqSeparator,
+		hash,
+	))
+}
+
 func keyForHeight(result *avsi.DVSRequestResult, idx *big.Int) []byte {
 	return []byte(fmt.Sprintf("%s/%d/%d/%d/%s%s",
 		types.DVSHeightKey,
@@ -0,0 +1,42 @@
+package kv
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPellNetwork/pelldvs-libs/log"
+	avsi "github.com/0xPellNetwork/pelldvs/avsi/types"
+	"github.com/0xPellNetwork/pelldvs/libs/query"
+)
+
+func TestIndexStatus(t *testing.T) {
+	idx := NewDvsRequestIndex(dbm.NewMemDB())
+	idx.SetLogger(log.NewNopLogger())
+
+	result := &avsi.DVSRequestResult{DvsRequest: &avsi.DVSRequest{Data: []byte("data"), Height: 10, ChainId: 1}}
+	result.SetStatus(avsi.DVS_REQUEST_STATUS_RECEIVED, time.Now())
+	require.NoError(t, idx.Index(result))
+
+	search := func(q string) []*avsi.DVSRequestResult {
+		results, err := idx.Search(context.Background(), query.MustCompile(q))
+		require.NoError(t, err)
+		return results
+	}
+	require.Len(t, search("request.status='RECEIVED'"), 1)
+
+	// a request is only found by its latest status
+	result.Fail("aggregation failed", time.Now())
+	require.NoError(t, idx.Index(result))
+	require.Empty(t, search("request.status='RECEIVED'"))
+
+	failed := search("request.status='FAILED'")
+	require.Len(t, failed, 1)
+	require.Equal(t, "aggregation failed", failed[0].FailureReason)
+	require.Len(t, failed[0].Transitions, 2)
+	require.Equal(t, avsi.DVS_REQUEST_STATUS_RECEIVED, failed[0].Transitions[0].Status)
+	require.Equal(t, "FAILED", failed[0].Status.Name())
+}
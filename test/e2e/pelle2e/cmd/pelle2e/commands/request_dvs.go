@@ -144,6 +144,7 @@ func processRequestDVSCmd(
 		chainID,
 		groupNumbers,
 		groupThresholdPercentages,
+		false,
 	)
 	logger.Info("RequestDVSAsync", "result", result, "err", err)
 	return nil
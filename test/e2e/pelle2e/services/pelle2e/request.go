@@ -61,6 +61,7 @@ func (per *PellDVSE2ERunner) RequestDVSAsync(ctx context.Context, req *types.DVS
 		req.ChainId,
 		req.GroupNumbers,
 		req.GroupThresholdPercentages,
+		false,
 	)
 	return result, err
 }
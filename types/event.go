@@ -1,6 +1,7 @@
 package types
 
 import (
+	"errors"
 	"sync"
 )
 
@@ -11,6 +12,20 @@ const (
 	CollectResponseSignatureDone    ReactorEventType = "CollectResponseSignatureDone"
 )
 
+const (
+	// reactorEventBufferSize is the number of events buffered in the channel
+	// of a subscription before further events are queued
+	reactorEventBufferSize = 16
+
+	// DefaultReactorEventQueueSize is the default number of events queued for
+	// a subscriber before further events are dropped
+	DefaultReactorEventQueueSize = 1024
+)
+
+// ErrReactorEventDropped is returned by Pub when the event was dropped for a
+// subscriber whose queue is full
+var ErrReactorEventDropped = errors.New("reactor event dropped: subscriber queue is full")
+
 // ReactorEvent is a struct that represents an event that can be published to the ReactorEventBus.
 type ReactorEvent struct {
 	Type    ReactorEventType
@@ -18,31 +33,193 @@ type ReactorEvent struct {
 }
 
 // ReactorEventBus is a struct that manages event subscriptions and publications.
+// Every subscriber of an event type receives all the events published for it.
+// Publishing never blocks: the events a subscriber is too slow to receive are
+// queued in order until it catches up, up to the queue size of the bus. Once
+// the queue of a subscriber is full, further events are dropped for it.
 type ReactorEventBus struct {
-	channels sync.Map // map[ReactorEventType]chan ReactorEvent
+	mtx       sync.Mutex
+	channels  sync.Map // map[ReactorEventType][]*subscription
+	queueSize int
+
+	onSlowConsumer func(eventType ReactorEventType, queued int)
+	onDropped      func(eventType ReactorEventType)
 }
 
 // NewReactorEventBus creates a new ReactorEventBus.
 func NewReactorEventBus() *ReactorEventBus {
 	return &ReactorEventBus{
-		channels: sync.Map{},
+		channels:  sync.Map{},
+		queueSize: DefaultReactorEventQueueSize,
 	}
 }
 
+// SetQueueSize sets the number of events queued for each subscriber before
+// further events are dropped. Values below 1 are ignored. It must be set
+// before subscribing.
+func (eb *ReactorEventBus) SetQueueSize(size int) {
+	if size > 0 {
+		eb.queueSize = size
+	}
+}
+
+// SetSlowConsumerHandler sets a function called with the number of queued
+// events whenever an event is queued for a subscriber whose channel is full.
+// It must be set before events are published.
+func (eb *ReactorEventBus) SetSlowConsumerHandler(fn func(eventType ReactorEventType, queued int)) {
+	eb.onSlowConsumer = fn
+}
+
+// SetDroppedEventHandler sets a function called whenever an event is dropped
+// for a subscriber whose queue is full. It must be set before events are
+// published.
+func (eb *ReactorEventBus) SetDroppedEventHandler(fn func(eventType ReactorEventType)) {
+	eb.onDropped = fn
+}
+
 // Sub subscribes to a ReactorEventType and returns a channel that will receive ReactorEvents.
 func (eb *ReactorEventBus) Sub(eventType ReactorEventType) <-chan ReactorEvent {
-	ch := make(chan ReactorEvent, 16)
-	eb.channels.Store(eventType, ch)
-	return ch
+	sub := &subscription{
+		out:       make(chan ReactorEvent, reactorEventBufferSize),
+		quit:      make(chan struct{}),
+		queueSize: eb.queueSize,
+	}
+
+	eb.mtx.Lock()
+	defer eb.mtx.Unlock()
+	subs := eb.subscriptions(eventType)
+	eb.channels.Store(eventType, append(subs[:len(subs):len(subs)], sub))
+	return sub.out
+}
+
+// Unsub removes the subscription of the channel returned by Sub. Events
+// queued for it are discarded and the channel is closed.
+func (eb *ReactorEventBus) Unsub(eventType ReactorEventType, ch <-chan ReactorEvent) {
+	eb.mtx.Lock()
+	subs := eb.subscriptions(eventType)
+	var removed *subscription
+	kept := make([]*subscription, 0, len(subs))
+	for _, sub := range subs {
+		if (<-chan ReactorEvent)(sub.out) == ch {
+			removed = sub
+			continue
+		}
+		kept = append(kept, sub)
+	}
+	eb.channels.Store(eventType, kept)
+	eb.mtx.Unlock()
+
+	if removed != nil {
+		removed.close()
+	}
 }
 
 // Pub publishes a ReactorEvent to all subscribers of the given ReactorEventType.
-func (eb *ReactorEventBus) Pub(eventType ReactorEventType, payload any) {
-	if chAny, exists := eb.channels.Load(eventType); exists {
-		ch := chAny.(chan ReactorEvent)
-		ch <- ReactorEvent{
-			Type:    eventType,
-			Payload: payload,
+// It returns ErrReactorEventDropped if the event was dropped for any of them,
+// so that the publisher can give up on what the event was meant to start.
+func (eb *ReactorEventBus) Pub(eventType ReactorEventType, payload any) error {
+	event := ReactorEvent{
+		Type:    eventType,
+		Payload: payload,
+	}
+	var err error
+	for _, sub := range eb.subscriptions(eventType) {
+		queued, ok := sub.deliver(event)
+		switch {
+		case !ok:
+			err = ErrReactorEventDropped
+			if eb.onDropped != nil {
+				eb.onDropped(eventType)
+			}
+		case queued > 0:
+			if eb.onSlowConsumer != nil {
+				eb.onSlowConsumer(eventType, queued)
+			}
 		}
 	}
+	return err
+}
+
+func (eb *ReactorEventBus) subscriptions(eventType ReactorEventType) []*subscription {
+	subs, ok := eb.channels.Load(eventType)
+	if !ok {
+		return nil
+	}
+	return subs.([]*subscription)
+}
+
+// subscription delivers the events of a subscriber to its channel, queueing
+// up to queueSize of them while the channel is full
+type subscription struct {
+	out       chan ReactorEvent
+	quit      chan struct{}
+	queueSize int
+
+	mtx     sync.Mutex
+	queue   []ReactorEvent
+	pumping bool
+	closed  bool
+	pump    sync.WaitGroup
+}
+
+// deliver sends the event to the channel if it has room and no events are
+// queued before it. Otherwise the event is queued and the number of queued
+// events is returned. It returns false if the queue is full and the event
+// was dropped.
+func (s *subscription) deliver(event ReactorEvent) (int, bool) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if s.closed {
+		return 0, true
+	}
+	if !s.pumping {
+		select {
+		case s.out <- event:
+			return 0, true
+		default:
+		}
+		s.pumping = true
+		s.pump.Add(1)
+		go s.pumpQueue()
+	}
+	if len(s.queue) >= s.queueSize {
+		return len(s.queue), false
+	}
+	s.queue = append(s.queue, event)
+	return len(s.queue), true
+}
+
+// pumpQueue sends the queued events to the channel in order, until the
+// queue is empty or the subscription is closed
+func (s *subscription) pumpQueue() {
+	defer s.pump.Done()
+	for {
+		s.mtx.Lock()
+		if len(s.queue) == 0 || s.closed {
+			s.pumping = false
+			s.mtx.Unlock()
+			return
+		}
+		event := s.queue[0]
+		s.queue = s.queue[1:]
+		s.mtx.Unlock()
+
+		select {
+		case s.out <- event:
+		case <-s.quit:
+			return
+		}
+	}
+}
+
+func (s *subscription) close() {
+	s.mtx.Lock()
+	s.closed = true
+	s.queue = nil
+	s.mtx.Unlock()
+
+	close(s.quit)
+	s.pump.Wait()
+	close(s.out)
 }
@@ -29,7 +29,7 @@ func TestPublish(t *testing.T) {
 	ch := eventBus.Sub(eventType)
 
 	payload := "test_payload"
-	eventBus.Pub(eventType, payload)
+	assert.NoError(t, eventBus.Pub(eventType, payload))
 
 	event := <-ch
 	assert.Equal(t, eventType, event.Type)
@@ -42,8 +42,120 @@ func TestPublishWithoutSubscribers(t *testing.T) {
 	eventType := ReactorEventType("test_event")
 
 	// Publish without any subscribers
-	eventBus.Pub(eventType, "test_payload")
+	assert.NoError(t, eventBus.Pub(eventType, "test_payload"))
 
 	// Ensure no panic or error occurs
 	assert.True(t, true)
 }
+
+// TestPublishToMultipleSubscribers tests that every subscriber receives the events.
+func TestPublishToMultipleSubscribers(t *testing.T) {
+	eventBus := NewReactorEventBus()
+	eventType := ReactorEventType("test_event")
+	ch1 := eventBus.Sub(eventType)
+	ch2 := eventBus.Sub(eventType)
+
+	assert.NoError(t, eventBus.Pub(eventType, "test_payload"))
+
+	assert.Equal(t, "test_payload", (<-ch1).Payload)
+	assert.Equal(t, "test_payload", (<-ch2).Payload)
+}
+
+// TestPublishToSlowSubscriber tests that publishing does not block on a full
+// subscriber, which then receives the queued events in order.
+func TestPublishToSlowSubscriber(t *testing.T) {
+	eventBus := NewReactorEventBus()
+	eventType := ReactorEventType("test_event")
+	var mtx sync.Mutex
+	maxQueued := 0
+	eventBus.SetSlowConsumerHandler(func(_ ReactorEventType, queued int) {
+		mtx.Lock()
+		defer mtx.Unlock()
+		maxQueued = max(maxQueued, queued)
+	})
+	ch := eventBus.Sub(eventType)
+
+	const events = 4 * reactorEventBufferSize
+	for i := 0; i < events; i++ {
+		assert.NoError(t, eventBus.Pub(eventType, i))
+	}
+
+	for i := 0; i < events; i++ {
+		assert.Equal(t, i, (<-ch).Payload)
+	}
+	mtx.Lock()
+	defer mtx.Unlock()
+	assert.Positive(t, maxQueued)
+}
+
+// TestPublishToFullSubscriber tests that events are dropped for a subscriber
+// whose queue is full, and that the events kept are received in order.
+func TestPublishToFullSubscriber(t *testing.T) {
+	const queueSize = 8
+	eventBus := NewReactorEventBus()
+	eventBus.SetQueueSize(queueSize)
+	eventType := ReactorEventType("test_event")
+	var mtx sync.Mutex
+	maxQueued, dropped := 0, 0
+	eventBus.SetSlowConsumerHandler(func(_ ReactorEventType, queued int) {
+		mtx.Lock()
+		defer mtx.Unlock()
+		maxQueued = max(maxQueued, queued)
+	})
+	eventBus.SetDroppedEventHandler(func(droppedType ReactorEventType) {
+		mtx.Lock()
+		defer mtx.Unlock()
+		assert.Equal(t, eventType, droppedType)
+		dropped++
+	})
+	ch := eventBus.Sub(eventType)
+
+	const events = reactorEventBufferSize + 4*queueSize
+	failed := 0
+	for i := 0; i < events; i++ {
+		if err := eventBus.Pub(eventType, i); err != nil {
+			assert.ErrorIs(t, err, ErrReactorEventDropped)
+			failed++
+		}
+	}
+
+	mtx.Lock()
+	assert.LessOrEqual(t, maxQueued, queueSize)
+	assert.Positive(t, dropped)
+	assert.Equal(t, dropped, failed)
+	received := events - dropped
+	mtx.Unlock()
+
+	last := -1
+	for i := 0; i < received; i++ {
+		payload := (<-ch).Payload.(int)
+		assert.Greater(t, payload, last)
+		last = payload
+	}
+	eventBus.Unsub(eventType, ch)
+	_, open := <-ch
+	assert.False(t, open)
+}
+
+// TestUnsubscribe tests that an unsubscribed channel is closed and receives no
+// further events, while the other subscribers still do.
+func TestUnsubscribe(t *testing.T) {
+	eventBus := NewReactorEventBus()
+	eventType := ReactorEventType("test_event")
+	ch1 := eventBus.Sub(eventType)
+	ch2 := eventBus.Sub(eventType)
+
+	for i := 0; i < 2*reactorEventBufferSize; i++ {
+		assert.NoError(t, eventBus.Pub(eventType, i))
+	}
+	eventBus.Unsub(eventType, ch1)
+	for range ch1 {
+		// drain the events buffered before unsubscribing
+	}
+
+	assert.NoError(t, eventBus.Pub(eventType, "after"))
+	for i := 0; i < 2*reactorEventBufferSize; i++ {
+		assert.Equal(t, i, (<-ch2).Payload)
+	}
+	assert.Equal(t, "after", (<-ch2).Payload)
+}
@@ -31,4 +31,8 @@ const (
 	// DVSChainID identifies which blockchain the DVS request belongs to
 	// Important for multi-chain environments to route requests properly
 	DVSChainID = "dvs.chainid"
+
+	// DVSStatusKey is a reserved key, used to specify DVS request's lifecycle status.
+	// Enables filtering DVS requests by status, e.g. request.status='FAILED'
+	DVSStatusKey = "request.status"
 )
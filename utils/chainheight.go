@@ -0,0 +1,101 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+
+	interactorcfg "github.com/0xPellNetwork/pelldvs-interactor/config"
+)
+
+// DefaultChainHeightTTL is how long a latest height read from a chain is
+// reused before it is read again
+const DefaultChainHeightTTL = 2 * time.Second
+
+// ChainHeightReader reads the latest block height of the chains of the
+// interactor config from their RPC endpoints. Heights are cached for a TTL,
+// so that checking requests does not make a chain call per request.
+type ChainHeightReader struct {
+	chainConfigs map[uint64]*interactorcfg.DVSConfig
+	ttl          time.Duration
+
+	mtx     sync.Mutex
+	clients map[uint64]*ethclient.Client
+	heights map[uint64]cachedHeight
+}
+
+// cachedHeight is the latest height of a chain and when it was read
+type cachedHeight struct {
+	height int64
+	readAt time.Time
+}
+
+// NewChainHeightReader creates a ChainHeightReader for the chains of the
+// interactor config, caching their heights for the TTL. Clients are
+// connected on first use.
+func NewChainHeightReader(interactorConfig *interactorcfg.Config, ttl time.Duration) *ChainHeightReader {
+	return &ChainHeightReader{
+		chainConfigs: interactorConfig.ContractConfig.DVSConfigs,
+		ttl:          ttl,
+		clients:      make(map[uint64]*ethclient.Client),
+		heights:      make(map[uint64]cachedHeight),
+	}
+}
+
+// ChainIDs returns the IDs of the chains of the interactor config
+func (r *ChainHeightReader) ChainIDs() []int64 {
+	chainIDs := make([]int64, 0, len(r.chainConfigs))
+	for chainID := range r.chainConfigs {
+		chainIDs = append(chainIDs, int64(chainID))
+	}
+	return chainIDs
+}
+
+// LatestHeight returns the number of the latest block of the chain, as
+// cached within the TTL or else read from the chain
+func (r *ChainHeightReader) LatestHeight(ctx context.Context, chainID int64) (int64, error) {
+	r.mtx.Lock()
+	cached, ok := r.heights[uint64(chainID)]
+	r.mtx.Unlock()
+	if ok && time.Since(cached.readAt) < r.ttl {
+		return cached.height, nil
+	}
+
+	client, err := r.client(uint64(chainID))
+	if err != nil {
+		return 0, err
+	}
+	height, err := client.BlockNumber(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get latest block of chain %d: %v", chainID, err)
+	}
+
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	// heights read concurrently may complete out of order
+	latest := max(int64(height), r.heights[uint64(chainID)].height)
+	r.heights[uint64(chainID)] = cachedHeight{height: latest, readAt: time.Now()}
+	return latest, nil
+}
+
+func (r *ChainHeightReader) client(chainID uint64) (*ethclient.Client, error) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	if client, ok := r.clients[chainID]; ok {
+		return client, nil
+	}
+	chainConfig, ok := r.chainConfigs[chainID]
+	if !ok {
+		return nil, fmt.Errorf("chain config not found for chain ID: %d", chainID)
+	}
+	client, err := ethclient.Dial(chainConfig.RPCURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to chain %d: %v", chainID, err)
+	}
+	r.clients[chainID] = client
+	return client, nil
+}